@@ -0,0 +1,84 @@
+package conduction
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+)
+
+func SaveCSV(path string, field *Field) error {
+	return saveFile(path, field, WriteCSV)
+}
+
+func SaveVTK(path string, field *Field) error {
+	return saveFile(path, field, WriteVTK)
+}
+
+// WriteCSV записывает координаты и температуры ячеек стенки (x, y, t)
+func WriteCSV(w io.Writer, field *Field) error {
+	var g = field.Grid
+	for j := 0; j != g.Ny; j++ {
+		for i := 0; i != g.Nx; i++ {
+			if g.KindAt(i, j) != Wall {
+				continue
+			}
+			var p = g.Center(i, j)
+			if _, err := fmt.Fprintf(w, "%v,%v,%v\n", p.X, p.Y, field.At(i, j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteVTK записывает поле в формате legacy VTK (STRUCTURED_POINTS) с данными по ячейкам.
+// Вне стенки температура равна нулю, тип ячейки задан отдельным полем.
+func WriteVTK(w io.Writer, field *Field) error {
+	var g = field.Grid
+	var header = fmt.Sprintf(
+		"# vtk DataFile Version 3.0\nblade wall temperature\nASCII\nDATASET STRUCTURED_POINTS\n"+
+			"DIMENSIONS %d %d 1\nORIGIN %v %v 0\nSPACING %v %v 1\nCELL_DATA %d\n",
+		g.Nx+1, g.Ny+1, g.X0, g.Y0, g.H, g.H, g.Nx*g.Ny,
+	)
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+
+	if _, err := io.WriteString(w, "SCALARS temperature double 1\nLOOKUP_TABLE default\n"); err != nil {
+		return err
+	}
+	for k, kind := range g.Kind {
+		var t = 0.
+		if kind == Wall {
+			t = field.T[k]
+		}
+		if _, err := fmt.Fprintf(w, "%v\n", t); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, "SCALARS kind int 1\nLOOKUP_TABLE default\n"); err != nil {
+		return err
+	}
+	for _, kind := range g.Kind {
+		if _, err := fmt.Fprintf(w, "%d\n", kind); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func saveFile(path string, field *Field, writeFunc func(io.Writer, *Field) error) error {
+	var f, err = os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var w = bufio.NewWriter(f)
+	if err := writeFunc(w, field); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,138 @@
+package conduction
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	Gas    = 0 // ячейка вне профиля
+	Wall   = 1 // ячейка стенки лопатки
+	Cavity = 2 // ячейка внутренней полости
+)
+
+type Point struct {
+	X float64
+	Y float64
+}
+
+func PointsFromCoordinates(coordinates [][]float64) []Point {
+	var result = make([]Point, len(coordinates))
+	for i, c := range coordinates {
+		result[i] = Point{X: c[0], Y: c[1]}
+	}
+	return result
+}
+
+// Grid - равномерная декартова сетка, покрывающая сечение лопатки.
+// Полость образована точками профиля, удаленными от внешнего контура более чем на толщину стенки.
+type Grid struct {
+	X0 float64
+	Y0 float64
+	H  float64
+	Nx int
+	Ny int
+
+	Contour []Point
+	WallThk float64
+	Kind    []int
+}
+
+func NewGrid(contour []Point, wallThk float64, h float64) (*Grid, error) {
+	if len(contour) < 3 {
+		return nil, fmt.Errorf("contour must contain at least 3 points")
+	}
+	if h <= 0 || wallThk <= 0 {
+		return nil, fmt.Errorf("grid step and wall thickness must be positive")
+	}
+	var xMin, yMin = math.Inf(1), math.Inf(1)
+	var xMax, yMax = math.Inf(-1), math.Inf(-1)
+	for _, p := range contour {
+		xMin, xMax = math.Min(xMin, p.X), math.Max(xMax, p.X)
+		yMin, yMax = math.Min(yMin, p.Y), math.Max(yMax, p.Y)
+	}
+
+	var grid = &Grid{
+		X0:      xMin - h,
+		Y0:      yMin - h,
+		H:       h,
+		Nx:      int(math.Ceil((xMax-xMin)/h)) + 2,
+		Ny:      int(math.Ceil((yMax-yMin)/h)) + 2,
+		Contour: contour,
+		WallThk: wallThk,
+	}
+	grid.Kind = make([]int, grid.Nx*grid.Ny)
+	for j := 0; j != grid.Ny; j++ {
+		for i := 0; i != grid.Nx; i++ {
+			var p = grid.Center(i, j)
+			switch {
+			case !Inside(contour, p):
+				grid.Kind[grid.Index(i, j)] = Gas
+			case Distance(contour, p) <= wallThk:
+				grid.Kind[grid.Index(i, j)] = Wall
+			default:
+				grid.Kind[grid.Index(i, j)] = Cavity
+			}
+		}
+	}
+	return grid, nil
+}
+
+func (g *Grid) Index(i, j int) int {
+	return j*g.Nx + i
+}
+
+func (g *Grid) Center(i, j int) Point {
+	return Point{
+		X: g.X0 + (float64(i)+0.5)*g.H,
+		Y: g.Y0 + (float64(j)+0.5)*g.H,
+	}
+}
+
+func (g *Grid) KindAt(i, j int) int {
+	if i < 0 || j < 0 || i >= g.Nx || j >= g.Ny {
+		return Gas
+	}
+	return g.Kind[g.Index(i, j)]
+}
+
+func (g *Grid) WallCellNum() int {
+	var result = 0
+	for _, kind := range g.Kind {
+		if kind == Wall {
+			result++
+		}
+	}
+	return result
+}
+
+func Inside(contour []Point, p Point) bool {
+	var result = false
+	for i, j := 0, len(contour)-1; i < len(contour); j, i = i, i+1 {
+		var a, b = contour[i], contour[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			result = !result
+		}
+	}
+	return result
+}
+
+func Distance(contour []Point, p Point) float64 {
+	var result = math.Inf(1)
+	for i := range contour {
+		var a, b = contour[i], contour[(i+1)%len(contour)]
+		result = math.Min(result, segmentDistance(a, b, p))
+	}
+	return result
+}
+
+func segmentDistance(a, b, p Point) float64 {
+	var dx, dy = b.X - a.X, b.Y - a.Y
+	var l2 = dx*dx + dy*dy
+	var t = 0.
+	if l2 > 0 {
+		t = math.Max(0, math.Min(1, ((p.X-a.X)*dx+(p.Y-a.Y)*dy)/l2))
+	}
+	var px, py = a.X + t*dx - p.X, a.Y + t*dy - p.Y
+	return math.Sqrt(px*px + py*py)
+}
@@ -0,0 +1,56 @@
+package conduction
+
+import (
+	"math"
+)
+
+// Polyline - ломаная с накопленной длиной дуги, используется для перехода от точки границы
+// к криволинейной координате, в которой заданы законы теплоотдачи одномерной модели.
+type Polyline struct {
+	Points []Point
+	Length []float64
+}
+
+func NewPolyline(points []Point) Polyline {
+	var length = make([]float64, len(points))
+	for i := 1; i < len(points); i++ {
+		var dx, dy = points[i].X - points[i-1].X, points[i].Y - points[i-1].Y
+		length[i] = length[i-1] + math.Sqrt(dx*dx+dy*dy)
+	}
+	return Polyline{Points: points, Length: length}
+}
+
+// Project возвращает криволинейную координату ближайшей точки ломаной и расстояние до нее
+func (l Polyline) Project(p Point) (s, dist float64) {
+	dist = math.Inf(1)
+	for i := 1; i < len(l.Points); i++ {
+		var a, b = l.Points[i-1], l.Points[i]
+		var dx, dy = b.X - a.X, b.Y - a.Y
+		var l2 = dx*dx + dy*dy
+		var t = 0.
+		if l2 > 0 {
+			t = math.Max(0, math.Min(1, ((p.X-a.X)*dx+(p.Y-a.Y)*dy)/l2))
+		}
+		var d = segmentDistance(a, b, p)
+		if d < dist {
+			dist = d
+			s = l.Length[i-1] + t*(l.Length[i]-l.Length[i-1])
+		}
+	}
+	return
+}
+
+// SideLocator определяет, к какой стороне профиля (корыто или спинка) относится точка границы
+type SideLocator struct {
+	PS Polyline
+	SS Polyline
+}
+
+func (l SideLocator) Locate(p Point) (isPS bool, s float64) {
+	var sPS, dPS = l.PS.Project(p)
+	var sSS, dSS = l.SS.Project(p)
+	if dPS <= dSS {
+		return true, sPS
+	}
+	return false, sSS
+}
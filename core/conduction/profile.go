@@ -0,0 +1,54 @@
+package conduction
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/interp"
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
+	"github.com/Sovianum/turbocycle/utils/turbine/geom"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
+)
+
+func NewProfileGrid(bladeProfile profiles.BladeProfile, wallThk, h float64, pointNum int) (*Grid, error) {
+	var contour = PointsFromCoordinates(geom.GetCoordinates(
+		common.LinSpace(0, 1, pointNum), profiles.CircularSegment(bladeProfile),
+	))
+	return NewGrid(contour, wallThk, h)
+}
+
+// NewProfileSideLocator строит ломаные корыта и спинки в тех же криволинейных координатах,
+// что используются одномерными системами (profiles.PSSegment, profiles.SSSegment)
+func NewProfileSideLocator(bladeProfile profiles.BladeProfile, pointNum int) SideLocator {
+	var tArr = common.LinSpace(0, 1, pointNum)
+	return SideLocator{
+		PS: NewPolyline(PointsFromCoordinates(geom.GetCoordinates(tArr, profiles.PSSegment(bladeProfile, 0.5, 0.5)))),
+		SS: NewPolyline(PointsFromCoordinates(geom.GetCoordinates(tArr, profiles.SSSegment(bladeProfile, 0.5, 0.5)))),
+	}
+}
+
+func NewGasBC(locator SideLocator, psAlphaGas, ssAlphaGas cooling.AlphaLaw, tGas float64) BoundaryCondition {
+	return func(p Point) (float64, float64) {
+		var isPS, s = locator.Locate(p)
+		if isPS {
+			return psAlphaGas(s, tGas), tGas
+		}
+		return ssAlphaGas(s, tGas), tGas
+	}
+}
+
+// NewCoolantBC берет температуру охладителя из решений одномерных систем для корыта и спинки
+func NewCoolantBC(
+	locator SideLocator,
+	psAlphaAir, ssAlphaAir cooling.AlphaLaw,
+	psSolution, ssSolution profile.TemperatureSolution,
+) BoundaryCondition {
+	return func(p Point) (float64, float64) {
+		var isPS, s = locator.Locate(p)
+		if isPS {
+			var tAir = interp.Linear(psSolution.LengthCoord, psSolution.AirTemperature, s)
+			return psAlphaAir(s, tAir), tAir
+		}
+		var tAir = interp.Linear(ssSolution.LengthCoord, ssSolution.AirTemperature, s)
+		return ssAlphaAir(s, tAir), tAir
+	}
+}
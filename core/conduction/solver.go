@@ -0,0 +1,179 @@
+package conduction
+
+import (
+	"fmt"
+	"math"
+)
+
+// BoundaryCondition возвращает коэффициент теплоотдачи и температуру среды в точке границы стенки
+type BoundaryCondition func(p Point) (alpha, tFluid float64)
+
+// Field - поле температур на сетке. Температура определена только в ячейках стенки.
+type Field struct {
+	Grid *Grid
+	T    []float64
+	Iter int
+}
+
+func (f *Field) At(i, j int) float64 {
+	return f.T[f.Grid.Index(i, j)]
+}
+
+func (f *Field) MinMax() (tMin, tMax float64) {
+	tMin, tMax = math.Inf(1), math.Inf(-1)
+	for k, kind := range f.Grid.Kind {
+		if kind == Wall {
+			tMin, tMax = math.Min(tMin, f.T[k]), math.Max(tMax, f.T[k])
+		}
+	}
+	return
+}
+
+type Solver interface {
+	Solve(t0 float64) (*Field, error)
+}
+
+func NewSolver(
+	grid *Grid,
+	lambdaFunc func(t float64) float64,
+	gasBC BoundaryCondition,
+	coolantBC BoundaryCondition,
+	relaxCoef, precision float64,
+	iterLimit int,
+) Solver {
+	return &solver{
+		grid:       grid,
+		lambdaFunc: lambdaFunc,
+		gasBC:      gasBC,
+		coolantBC:  coolantBC,
+		relaxCoef:  relaxCoef,
+		precision:  precision,
+		iterLimit:  iterLimit,
+	}
+}
+
+type solver struct {
+	grid       *Grid
+	lambdaFunc func(t float64) float64
+	gasBC      BoundaryCondition
+	coolantBC  BoundaryCondition
+	relaxCoef  float64
+	precision  float64
+	iterLimit  int
+}
+
+var neighbours = [4][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}}
+
+// faceCondition - граничное условие на грани ячейки стенки, граничащей с газом или полостью
+type faceCondition struct {
+	alpha  float64
+	tFluid float64
+}
+
+// Solve решает стационарную задачу теплопроводности методом контрольных объемов
+// (последовательная верхняя релаксация). Грани ячеек стенки, граничащие с газом или полостью,
+// получают конвективное граничное условие третьего рода.
+func (s *solver) Solve(t0 float64) (*Field, error) {
+	var g = s.grid
+	if g.WallCellNum() == 0 {
+		return nil, fmt.Errorf("grid contains no wall cells")
+	}
+	var conditions = s.faceConditions()
+	var field = &Field{Grid: g, T: make([]float64, len(g.Kind))}
+	for k, kind := range g.Kind {
+		if kind == Wall {
+			field.T[k] = t0
+		}
+	}
+
+	for iter := 0; iter != s.iterLimit; iter++ {
+		var residual = 0.
+		for j := 0; j != g.Ny; j++ {
+			for i := 0; i != g.Nx; i++ {
+				var k = g.Index(i, j)
+				if g.Kind[k] != Wall {
+					continue
+				}
+				var dt = s.relaxCoef * (s.cellTemperature(field, conditions, i, j) - field.T[k])
+				field.T[k] += dt
+				residual = math.Max(residual, math.Abs(dt))
+			}
+		}
+		field.Iter = iter + 1
+		if math.IsNaN(residual) {
+			return nil, fmt.Errorf("conduction solver diverged on iter %d", iter)
+		}
+		if residual < s.precision {
+			return field, nil
+		}
+	}
+	return nil, fmt.Errorf("conduction solver failed to converge in %d iterations", s.iterLimit)
+}
+
+// faceConditions вычисляет граничные условия на гранях ячеек стенки один раз до начала итераций:
+// они не зависят от температуры стенки. Индекс грани - 4 * индекс ячейки + номер соседа.
+func (s *solver) faceConditions() map[int]faceCondition {
+	var g = s.grid
+	var result = make(map[int]faceCondition)
+	for j := 0; j != g.Ny; j++ {
+		for i := 0; i != g.Nx; i++ {
+			if g.KindAt(i, j) != Wall {
+				continue
+			}
+			var center = g.Center(i, j)
+			for n, d := range neighbours {
+				var bc BoundaryCondition
+				switch g.KindAt(i+d[0], j+d[1]) {
+				case Gas:
+					bc = s.gasBC
+				case Cavity:
+					bc = s.coolantBC
+				default:
+					continue
+				}
+				var alpha, tFluid = bc(Point{
+					X: center.X + float64(d[0])*g.H/2,
+					Y: center.Y + float64(d[1])*g.H/2,
+				})
+				result[4*g.Index(i, j)+n] = faceCondition{alpha: alpha, tFluid: tFluid}
+			}
+		}
+	}
+	return result
+}
+
+func (s *solver) cellTemperature(field *Field, conditions map[int]faceCondition, i, j int) float64 {
+	var g = s.grid
+	var tP = field.At(i, j)
+	var lambdaP = s.lambdaFunc(tP)
+
+	var sumG, sumGT = 0., 0.
+	for n, d := range neighbours {
+		var ni, nj = i + d[0], j + d[1]
+
+		var conductance, tNeighbour float64
+		if g.KindAt(ni, nj) == Wall {
+			var tN = field.At(ni, nj)
+			var lambdaN = s.lambdaFunc(tN)
+			conductance = 2 * lambdaP * lambdaN / (lambdaP + lambdaN)
+			tNeighbour = tN
+		} else {
+			var c = conditions[4*g.Index(i, j)+n]
+			conductance, tNeighbour = convectiveConductance(c.alpha, lambdaP, g.H), c.tFluid
+		}
+		sumG += conductance
+		sumGT += conductance * tNeighbour
+	}
+	if sumG == 0 {
+		return tP
+	}
+	return sumGT / sumG
+}
+
+// convectiveConductance - проводимость грани единичной глубины между центром ячейки и средой
+func convectiveConductance(alpha, lambda, h float64) float64 {
+	if alpha <= 0 {
+		return 0
+	}
+	return h / (1/alpha + h/(2*lambda))
+}
@@ -0,0 +1,119 @@
+package conduction
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const (
+	plateLength = 0.1
+	plateThk    = 0.01
+	gridStep    = 5e-4
+	lambda      = 20.
+	alpha       = 1000.
+	tHot        = 1000.
+	tCold       = 500.
+)
+
+func TestSolver_Plate(t *testing.T) {
+	var contour = []Point{{0, 0}, {plateLength, 0}, {plateLength, plateThk}, {0, plateThk}}
+	var grid, err = NewGrid(contour, plateThk, gridStep)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, countKind(grid, Cavity))
+
+	var solver = NewSolver(
+		grid,
+		func(t float64) float64 { return lambda },
+		func(p Point) (float64, float64) {
+			switch {
+			case p.X <= 0 || p.X >= plateLength:
+				return 0, 0
+			case p.Y >= plateThk:
+				return alpha, tHot
+			default:
+				return alpha, tCold
+			}
+		},
+		func(p Point) (float64, float64) { return 0, 0 },
+		1.8, 1e-6, 100000,
+	)
+	field, err := solver.Solve(tCold)
+	assert.NoError(t, err)
+
+	var q = (tHot - tCold) / (2/alpha + plateThk/lambda)
+	var tMin, tMax = field.MinMax()
+	var hCell = gridStep / 2
+	assert.InDelta(t, tHot-q/alpha-q*hCell/lambda, tMax, 1)
+	assert.InDelta(t, tCold+q/alpha+q*hCell/lambda, tMin, 1)
+}
+
+func TestSolver_BoundaryConditionsEvaluatedOnce(t *testing.T) {
+	var contour = []Point{{0, 0}, {0.02, 0}, {0.02, 0.02}, {0, 0.02}}
+	var grid, err = NewGrid(contour, 2e-3, 5e-4)
+	assert.NoError(t, err)
+
+	var faces = make(map[Point]int)
+	var bc = func(tFluid float64) BoundaryCondition {
+		return func(p Point) (float64, float64) {
+			faces[p]++
+			return alpha, tFluid
+		}
+	}
+	field, err := NewSolver(
+		grid, func(t float64) float64 { return lambda }, bc(tHot), bc(tCold), 1.8, 1e-6, 100000,
+	).Solve(tCold)
+	assert.NoError(t, err)
+	assert.True(t, field.Iter > 1)
+	assert.NotEmpty(t, faces)
+	for p, n := range faces {
+		assert.Equal(t, 1, n, "face %v", p)
+	}
+}
+
+func TestGrid_Cavity(t *testing.T) {
+	var contour = []Point{{0, 0}, {0.02, 0}, {0.02, 0.02}, {0, 0.02}}
+	var grid, err = NewGrid(contour, 2e-3, 5e-4)
+	assert.NoError(t, err)
+	assert.True(t, countKind(grid, Cavity) > 0)
+	assert.True(t, countKind(grid, Wall) > 0)
+
+	var solver = NewSolver(
+		grid,
+		func(t float64) float64 { return lambda },
+		func(p Point) (float64, float64) { return alpha, tHot },
+		func(p Point) (float64, float64) { return alpha, tCold },
+		1.8, 1e-6, 100000,
+	)
+	field, err := solver.Solve(tCold)
+	assert.NoError(t, err)
+	var tMin, tMax = field.MinMax()
+	assert.True(t, tMin > tCold)
+	assert.True(t, tMax < tHot)
+
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteCSV(buf, field))
+	assert.Equal(t, grid.WallCellNum(), strings.Count(buf.String(), "\n"))
+
+	buf.Reset()
+	assert.NoError(t, WriteVTK(buf, field))
+	assert.Contains(t, buf.String(), "DATASET STRUCTURED_POINTS")
+}
+
+func TestPolyline_Project(t *testing.T) {
+	var line = NewPolyline([]Point{{0, 0}, {1, 0}, {1, 1}})
+	var s, dist = line.Project(Point{1.2, 0.5})
+	assert.InDelta(t, 1.5, s, 1e-9)
+	assert.InDelta(t, 0.2, dist, 1e-9)
+}
+
+func countKind(grid *Grid, kind int) int {
+	var result = 0
+	for _, k := range grid.Kind {
+		if k == kind {
+			result++
+		}
+	}
+	return result
+}
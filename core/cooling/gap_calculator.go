@@ -1,25 +1,31 @@
 package cooling
 
 import (
+	"github.com/Sovianum/cooling-course-project/core/cooling/nusselt"
 	"github.com/Sovianum/turbocycle/impl/engine/states"
 	states2 "github.com/Sovianum/turbocycle/impl/stage/states"
 	"github.com/Sovianum/turbocycle/impl/stage/turbine"
 	"github.com/Sovianum/turbocycle/material/gases"
 	"github.com/Sovianum/turbocycle/utils/turbine/cooling/gap"
 	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
-	"math"
 )
 
 const (
-	wallThk      = 1e-3
-	lambdaM      = 20
-	tWallOuter   = 1000
-	tCoolerInlet = 500
+	tWallOuter = 1000
+)
+
+const (
+	TCoolerInlet = 500 // температура охладителя на входе в лопатку (в корне рабочей лопатки), К
+
+	PrGas   = 0.72 // число Прандтля продуктов сгорания
+	WallThk = 1e-3 // толщина стенки лопатки, м
+	LambdaM = 20   // теплопроводность материала лопатки, Вт/(м К)
 )
 
 func GetInitedStatorGapCalculator(
 	stage turbine.StageNode,
 	profile profiles.BladeProfile,
+	correlation nusselt.Correlation,
 ) (gap.GapCalculator, error) {
 	var dataPack = stage.GetDataPack()
 	if dataPack.Err != nil {
@@ -35,13 +41,11 @@ func GetInitedStatorGapCalculator(
 		ca, pGas,
 		dataPack.StageGeometry.StatorGeometry(),
 		profile,
-		wallThk,
-		lambdaM,
-		func(re float64) float64 {
-			return 0.079 * math.Pow(re, 0.68)
-		},
+		WallThk,
+		LambdaM,
+		nusselt.ReLaw(correlation, PrGas),
 		tGas,
 		tWallOuter,
-		tCoolerInlet,
+		TCoolerInlet,
 	), nil
 }
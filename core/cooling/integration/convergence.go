@@ -0,0 +1,65 @@
+package integration
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/interp"
+	"math"
+)
+
+type ConvergencePoint struct {
+	Config   Config
+	PointNum int
+	YEnd     float64
+	MaxDiff  float64 // максимальное отклонение от решения с самыми жесткими настройками
+}
+
+// RunFunc рассчитывает решение с заданными настройками и возвращает его в виде таблицы (x, y)
+type RunFunc func(conf Config) (xArr, yArr []float64, err error)
+
+// RefinedConfigs возвращает num настроек, в каждой из которых шаг и допуск
+// уменьшены в factor раз по сравнению с предыдущей
+func RefinedConfigs(conf Config, num int, factor float64) []Config {
+	var result = make([]Config, num)
+	for i := range result {
+		result[i] = conf
+		conf.Step /= factor
+		conf.Tolerance /= factor
+	}
+	return result
+}
+
+// ConvergenceStudy сравнивает решения, полученные с последовательно уточняемыми настройками.
+// Отклонения вычисляются относительно последнего (самого точного) решения.
+func ConvergenceStudy(run RunFunc, confs []Config) ([]ConvergencePoint, error) {
+	if len(confs) == 0 {
+		return nil, fmt.Errorf("no configs to compare")
+	}
+	var xArrs = make([][]float64, len(confs))
+	var yArrs = make([][]float64, len(confs))
+	for i, conf := range confs {
+		var xArr, yArr, err = run(conf)
+		if err != nil {
+			return nil, err
+		}
+		if len(xArr) == 0 || len(xArr) != len(yArr) {
+			return nil, fmt.Errorf("invalid solution for config %d", i)
+		}
+		xArrs[i], yArrs[i] = xArr, yArr
+	}
+
+	var xRef, yRef = xArrs[len(confs)-1], yArrs[len(confs)-1]
+	var result = make([]ConvergencePoint, len(confs))
+	for i, conf := range confs {
+		var maxDiff = 0.
+		for j, x := range xArrs[i] {
+			maxDiff = math.Max(maxDiff, math.Abs(yArrs[i][j]-interp.Linear(xRef, yRef, x)))
+		}
+		result[i] = ConvergencePoint{
+			Config:   conf,
+			PointNum: len(xArrs[i]),
+			YEnd:     yArrs[i][len(yArrs[i])-1],
+			MaxDiff:  maxDiff,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package integration
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	safetyFactor  = 0.9
+	minStepFactor = 0.2
+	maxStepFactor = 5.
+)
+
+// коэффициенты метода Дормана-Принса 5(4)
+var (
+	dpC = [7]float64{0, 1. / 5, 3. / 10, 4. / 5, 8. / 9, 1, 1}
+	dpA = [7][6]float64{
+		{},
+		{1. / 5},
+		{3. / 40, 9. / 40},
+		{44. / 45, -56. / 15, 32. / 9},
+		{19372. / 6561, -25360. / 2187, 64448. / 6561, -212. / 729},
+		{9017. / 3168, -355. / 33, 46732. / 5247, 49. / 176, -5103. / 18656},
+		{35. / 384, 0, 500. / 1113, 125. / 192, -2187. / 6784, 11. / 84},
+	}
+	dpB = [7]float64{35. / 384, 0, 500. / 1113, 125. / 192, -2187. / 6784, 11. / 84, 0}
+	dpE = [7]float64{
+		71. / 57600, 0, -71. / 16695, 71. / 1920, -17253. / 339200, 22. / 525, -1. / 40,
+	}
+)
+
+// DormandPrinceStep выполняет шаг метода 5-го порядка и возвращает оценку локальной погрешности
+func DormandPrinceStep(f Func, x, y, h float64) (yNew, err float64) {
+	var k [7]float64
+	for i := 0; i != 7; i++ {
+		var yi = y
+		for j := 0; j != i; j++ {
+			yi += h * dpA[i][j] * k[j]
+		}
+		k[i] = f(x+dpC[i]*h, yi)
+	}
+	yNew = y
+	for i := 0; i != 7; i++ {
+		yNew += h * dpB[i] * k[i]
+		err += h * dpE[i] * k[i]
+	}
+	return yNew, math.Abs(err)
+}
+
+type adaptiveIntegrator struct {
+	step0     float64
+	minStep   float64
+	tolerance float64
+	events    []float64
+}
+
+func (integrator *adaptiveIntegrator) Integrate(f Func, x0, y0, xMax float64) (Result, error) {
+	var result = Result{X: []float64{x0}, Y: []float64{y0}}
+	var breakpoints = getBreakpoints(x0, xMax, integrator.events)
+
+	var y = y0
+	var h = integrator.step0
+	for i := 1; i < len(breakpoints); i++ {
+		var x, b = breakpoints[i-1], breakpoints[i]
+		var fi = intervalFunc(f, b)
+		for x < b {
+			var last = x+h >= b
+			var hStep = h
+			if last {
+				hStep = b - x
+			}
+
+			var yNew, errEstimate = DormandPrinceStep(fi, x, y, hStep)
+			if math.IsNaN(yNew) || math.IsInf(yNew, 0) {
+				return result, fmt.Errorf("solution diverged at x = %v", x+hStep)
+			}
+			var errNorm = errEstimate / (integrator.tolerance * (1 + math.Max(math.Abs(y), math.Abs(yNew))))
+
+			var factor = maxStepFactor
+			if errNorm > 0 {
+				factor = math.Max(minStepFactor, math.Min(maxStepFactor, safetyFactor*math.Pow(errNorm, -0.2)))
+			}
+
+			if errNorm > 1 {
+				result.Rejected++
+				h = hStep * factor
+				if h < integrator.minStep {
+					return result, fmt.Errorf(
+						"step %v at x = %v is below minimal step %v", h, x, integrator.minStep,
+					)
+				}
+				continue
+			}
+
+			if last {
+				x = b
+			} else {
+				x += hStep
+			}
+			y = yNew
+			result.X = append(result.X, x)
+			result.Y = append(result.Y, y)
+			result.Steps++
+
+			if !last {
+				h = hStep * factor
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,131 @@
+package integration
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	Euler = "euler"
+	RK4   = "rk4"
+	RK45  = "rk45"
+)
+
+// Func - правая часть уравнения dy/dx = f(x, y)
+type Func func(x, y float64) float64
+
+type Config struct {
+	Method    string
+	Step      float64 // шаг интегрирования (начальный шаг для адаптивного метода)
+	Tolerance float64 // допустимая локальная погрешность адаптивного метода
+	MinStep   float64 // минимальный шаг адаптивного метода
+}
+
+type Result struct {
+	X        []float64
+	Y        []float64
+	Steps    int
+	Rejected int
+}
+
+type Integrator interface {
+	Integrate(f Func, x0, y0, xMax float64) (Result, error)
+}
+
+func Methods() []string {
+	return []string{Euler, RK4, RK45}
+}
+
+// New создает интегратор. В точках events (например, координатах щелей) шаг обрывается,
+// так что разрывы правой части не попадают внутрь шага.
+func New(conf Config, events []float64) (Integrator, error) {
+	if conf.Step <= 0 {
+		return nil, fmt.Errorf("integration step must be positive, got %v", conf.Step)
+	}
+	switch conf.Method {
+	case Euler:
+		return &fixedStepIntegrator{step: conf.Step, events: events, stepFunc: EulerStep}, nil
+	case RK4:
+		return &fixedStepIntegrator{step: conf.Step, events: events, stepFunc: RK4Step}, nil
+	case RK45:
+		if conf.Tolerance <= 0 {
+			return nil, fmt.Errorf("rk45 tolerance must be positive, got %v", conf.Tolerance)
+		}
+		return &adaptiveIntegrator{
+			step0:     conf.Step,
+			minStep:   conf.MinStep,
+			tolerance: conf.Tolerance,
+			events:    events,
+		}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unknown integration method \"%s\" (available: %s)", conf.Method, strings.Join(Methods(), ", "),
+		)
+	}
+}
+
+func EulerStep(f Func, x, y, h float64) float64 {
+	return y + h*f(x, y)
+}
+
+func RK4Step(f Func, x, y, h float64) float64 {
+	var k1 = f(x, y)
+	var k2 = f(x+h/2, y+h*k1/2)
+	var k3 = f(x+h/2, y+h*k2/2)
+	var k4 = f(x+h, y+h*k3)
+	return y + h*(k1+2*k2+2*k3+k4)/6
+}
+
+type fixedStepIntegrator struct {
+	step     float64
+	events   []float64
+	stepFunc func(f Func, x, y, h float64) float64
+}
+
+func (integrator *fixedStepIntegrator) Integrate(f Func, x0, y0, xMax float64) (Result, error) {
+	var result = Result{X: []float64{x0}, Y: []float64{y0}}
+	var breakpoints = getBreakpoints(x0, xMax, integrator.events)
+
+	var y = y0
+	for i := 1; i < len(breakpoints); i++ {
+		var a, b = breakpoints[i-1], breakpoints[i]
+		var fi = intervalFunc(f, b)
+		var n = int(math.Ceil((b - a) / integrator.step))
+		var h = (b - a) / float64(n)
+		for j := 0; j != n; j++ {
+			var x = a + float64(j)*h
+			y = integrator.stepFunc(fi, x, y, h)
+			if math.IsNaN(y) || math.IsInf(y, 0) {
+				return result, fmt.Errorf("solution diverged at x = %v", x+h)
+			}
+			result.X = append(result.X, a+float64(j+1)*h)
+			result.Y = append(result.Y, y)
+			result.Steps++
+		}
+	}
+	return result, nil
+}
+
+// intervalFunc вычисляет правую часть в конце интервала как предел слева,
+// чтобы скачок в точке события относился к следующему интервалу
+func intervalFunc(f Func, b float64) Func {
+	var bLeft = math.Nextafter(b, math.Inf(-1))
+	return func(x, y float64) float64 {
+		return f(math.Min(x, bLeft), y)
+	}
+}
+
+// getBreakpoints возвращает отсортированные точки от x0 до xMax, включающие события внутри интервала
+func getBreakpoints(x0, xMax float64, events []float64) []float64 {
+	var result = []float64{x0}
+	var sorted = append([]float64{}, events...)
+	sort.Float64s(sorted)
+	for _, e := range sorted {
+		if e > result[len(result)-1] && e < xMax {
+			result = append(result, e)
+		}
+	}
+	return append(result, xMax)
+}
@@ -0,0 +1,77 @@
+package integration
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func exponent(x, y float64) float64 {
+	return -y
+}
+
+// step имитирует разрыв правой части в точке щели
+func step(x, y float64) float64 {
+	if x < 0.3 {
+		return 1
+	}
+	return -2
+}
+
+func TestIntegrators_Exponent(t *testing.T) {
+	var expected = math.Exp(-1)
+	var cases = []struct {
+		conf  Config
+		delta float64
+	}{
+		{Config{Method: Euler, Step: 1e-3}, 1e-3},
+		{Config{Method: RK4, Step: 1e-2}, 1e-9},
+		{Config{Method: RK45, Step: 1e-2, Tolerance: 1e-8, MinStep: 1e-12}, 1e-7},
+	}
+	for _, c := range cases {
+		var integrator, err = New(c.conf, nil)
+		assert.NoError(t, err)
+		result, err := integrator.Integrate(exponent, 0, 1, 1)
+		assert.NoError(t, err)
+		assert.InDelta(t, 1, result.X[len(result.X)-1], 1e-12, c.conf.Method)
+		assert.InDelta(t, expected, result.Y[len(result.Y)-1], c.delta, c.conf.Method)
+	}
+}
+
+func TestIntegrators_Events(t *testing.T) {
+	for _, method := range Methods() {
+		var integrator, err = New(Config{Method: method, Step: 0.07, Tolerance: 1e-6, MinStep: 1e-12}, []float64{0.3})
+		assert.NoError(t, err)
+		result, err := integrator.Integrate(step, 0, 0, 1)
+		assert.NoError(t, err)
+		assert.Contains(t, result.X, 0.3, method)
+		assert.InDelta(t, 0.3-2*0.7, result.Y[len(result.Y)-1], 1e-9, method)
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	var _, err = New(Config{Method: "midpoint", Step: 1e-3}, nil)
+	assert.Error(t, err)
+	_, err = New(Config{Method: RK45, Step: 1e-3}, nil)
+	assert.Error(t, err)
+}
+
+func TestConvergenceStudy(t *testing.T) {
+	var confs = RefinedConfigs(Config{Method: Euler, Step: 0.1}, 4, 2)
+	assert.InDelta(t, 0.0125, confs[3].Step, 1e-12)
+
+	var points, err = ConvergenceStudy(func(conf Config) ([]float64, []float64, error) {
+		var integrator, err = New(conf, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var result, solveErr = integrator.Integrate(exponent, 0, 1, 1)
+		return result.X, result.Y, solveErr
+	}, confs)
+	assert.NoError(t, err)
+	assert.Len(t, points, 4)
+	for i := 1; i < len(points); i++ {
+		assert.True(t, points[i].MaxDiff < points[i-1].MaxDiff)
+	}
+	assert.InDelta(t, 0, points[3].MaxDiff, 1e-12)
+}
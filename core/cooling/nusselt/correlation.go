@@ -0,0 +1,175 @@
+package nusselt
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Correlation interface {
+	Name() string
+	Title() string
+	Formula() string
+	Substitution(re, pr float64) string
+
+	Nu(re, pr float64) float64
+	ReRange() (reMin, reMax float64)
+	PrRange() (prMin, prMax float64)
+}
+
+func NewPowerLaw(name, title string, coef, reExp, prExp, reMin, reMax float64) Correlation {
+	return &powerLaw{
+		correlationInfo: correlationInfo{
+			name:  name,
+			title: title,
+			reMin: reMin, reMax: reMax,
+			prMin: 0, prMax: math.Inf(1),
+		},
+		coef:  coef,
+		reExp: reExp,
+		prExp: prExp,
+	}
+}
+
+func NewGnielinski() Correlation {
+	return &gnielinski{
+		correlationInfo: correlationInfo{
+			name:  Gnielinski,
+			title: "Гниелински (турбулентное течение в канале)",
+			reMin: 3e3, reMax: 5e6,
+			prMin: 0.5, prMax: 2e3,
+		},
+	}
+}
+
+// Warning возвращает ошибку, если число Re или Pr выходит за пределы применимости зависимости
+func Warning(c Correlation, re, pr float64) error {
+	var reMin, reMax = c.ReRange()
+	if re < reMin || re > reMax {
+		return fmt.Errorf(
+			"correlation %s: Re = %.3e is out of range [%.3e, %.3e]", c.Name(), re, reMin, reMax,
+		)
+	}
+	var prMin, prMax = c.PrRange()
+	if pr < prMin || pr > prMax {
+		return fmt.Errorf(
+			"correlation %s: Pr = %.3f is out of range [%.3f, %.3f]", c.Name(), pr, prMin, prMax,
+		)
+	}
+	return nil
+}
+
+// ReLaw приводит зависимость к виду Nu(Re) при фиксированном числе Pr.
+// Применимость к полученному числу Re проверяется вызывающим кодом с помощью Warning.
+func ReLaw(c Correlation, pr float64) func(re float64) float64 {
+	return func(re float64) float64 {
+		return c.Nu(re, pr)
+	}
+}
+
+type correlationInfo struct {
+	name  string
+	title string
+	reMin float64
+	reMax float64
+	prMin float64
+	prMax float64
+}
+
+func (info correlationInfo) Name() string {
+	return info.name
+}
+
+func (info correlationInfo) Title() string {
+	return info.title
+}
+
+func (info correlationInfo) ReRange() (float64, float64) {
+	return info.reMin, info.reMax
+}
+
+func (info correlationInfo) PrRange() (float64, float64) {
+	return info.prMin, info.prMax
+}
+
+type powerLaw struct {
+	correlationInfo
+	coef  float64
+	reExp float64
+	prExp float64
+}
+
+func (law *powerLaw) Coef() float64 {
+	return law.coef
+}
+
+func (law *powerLaw) Nu(re, pr float64) float64 {
+	return law.coef * math.Pow(re, law.reExp) * math.Pow(pr, law.prExp)
+}
+
+func (law *powerLaw) Formula() string {
+	var result = fmt.Sprintf("Nu = %s \\cdot Re^{%s}", formatNum(law.coef), formatNum(law.reExp))
+	if law.prExp != 0 {
+		result += fmt.Sprintf(" \\cdot Pr^{%s}", formatNum(law.prExp))
+	}
+	return result
+}
+
+func (law *powerLaw) Substitution(re, pr float64) string {
+	var result = fmt.Sprintf(
+		"%s \\cdot \\left( %s \\right)^{%s}",
+		formatNum(law.coef), formatRe(re), formatNum(law.reExp),
+	)
+	if law.prExp != 0 {
+		result += fmt.Sprintf(" \\cdot %s^{%s}", formatNum(pr), formatNum(law.prExp))
+	}
+	return result
+}
+
+type gnielinski struct {
+	correlationInfo
+}
+
+func (law *gnielinski) Nu(re, pr float64) float64 {
+	var f = law.friction(re)
+	return (f / 8) * (re - 1000) * pr / (1 + 12.7*math.Sqrt(f/8)*(math.Pow(pr, 2./3)-1))
+}
+
+func (law *gnielinski) Formula() string {
+	return "Nu = \\frac{(\\xi / 8)(Re - 1000) Pr}{1 + 12.7 \\sqrt{\\xi / 8} \\left( Pr^{2/3} - 1 \\right)}, " +
+		"\\xi = \\left( 0.79 \\ln Re - 1.64 \\right)^{-2}"
+}
+
+func (law *gnielinski) Substitution(re, pr float64) string {
+	var f = law.friction(re)
+	return fmt.Sprintf(
+		"\\frac{(%s / 8)(%s - 1000) \\cdot %s}{1 + 12.7 \\sqrt{%s / 8} \\left( %s^{2/3} - 1 \\right)}",
+		formatNum(f), formatRe(re), formatNum(pr), formatNum(f), formatNum(pr),
+	)
+}
+
+func (law *gnielinski) friction(re float64) float64 {
+	return math.Pow(0.79*math.Log(re)-1.64, -2)
+}
+
+// formatNum выводит число в формате LaTeX: порядок записывается множителем 10^{n}
+func formatNum(value float64) string {
+	if math.IsInf(value, 0) {
+		if value < 0 {
+			return "-\\infty"
+		}
+		return "\\infty"
+	}
+	var s = strconv.FormatFloat(value, 'g', 4, 64)
+	var i = strings.IndexByte(s, 'e')
+	if i < 0 {
+		return s
+	}
+	var exp, _ = strconv.Atoi(s[i+1:])
+	return fmt.Sprintf("%s \\cdot 10^{%d}", s[:i], exp)
+}
+
+func formatRe(re float64) string {
+	return fmt.Sprintf("%.0f \\cdot 10^3", re/1e3)
+}
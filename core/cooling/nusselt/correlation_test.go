@@ -0,0 +1,64 @@
+package nusselt
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+const (
+	prAny = 0.72
+)
+
+func TestGet(t *testing.T) {
+	for _, name := range Names() {
+		var c, err = Get(name)
+		assert.NoError(t, err)
+		assert.Equal(t, name, c.Name())
+	}
+
+	var _, err = Get("unknown")
+	assert.Error(t, err)
+}
+
+func TestRegister(t *testing.T) {
+	var law = NewPowerLaw("test-law", "test", 0.1, 0.7, 0, 1e3, 1e6)
+	defer delete(registry, law.Name())
+	assert.NoError(t, Register(law))
+	assert.Error(t, Register(law))
+
+	var c, err = Get("test-law")
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.1*math.Pow(1e5, 0.7), c.Nu(1e5, 0.7), 1e-9)
+}
+
+func TestIvanovBlade(t *testing.T) {
+	var c, _ = Get(IvanovBlade)
+	assert.InDelta(t, 0.079*math.Pow(2e5, 0.68), c.Nu(2e5, prAny), 1e-9)
+}
+
+func TestGnielinski_MatchesDittusBoelter(t *testing.T) {
+	var g, _ = Get(Gnielinski)
+	var db, _ = Get(DittusBoelter)
+
+	var nuG = g.Nu(5e4, 0.7)
+	var nuDB = db.Nu(5e4, 0.7)
+	assert.InDelta(t, 0, (nuG-nuDB)/nuDB, 0.15)
+}
+
+func TestWarning(t *testing.T) {
+	var c, _ = Get(FlatPlateLaminar)
+	assert.NoError(t, Warning(c, 1e5, 0.7))
+	assert.Error(t, Warning(c, 1e6, 0.7))
+
+	var g, _ = Get(Gnielinski)
+	assert.Error(t, Warning(g, 1e4, 0.1))
+}
+
+func TestFormatNum(t *testing.T) {
+	assert.Equal(t, "0.079", formatNum(0.079))
+	assert.Equal(t, "0.3333", formatNum(1./3))
+	assert.Equal(t, "1 \\cdot 10^{4}", formatNum(1e4))
+	assert.Equal(t, "2.5 \\cdot 10^{-5}", formatNum(2.5e-5))
+	assert.Equal(t, "\\infty", formatNum(math.Inf(1)))
+}
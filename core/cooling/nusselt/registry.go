@@ -0,0 +1,63 @@
+package nusselt
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	IvanovBlade         = "ivanov-blade"
+	DittusBoelter       = "dittus-boelter"
+	Gnielinski          = "gnielinski"
+	FlatPlateLaminar    = "flat-plate-laminar"
+	FlatPlateTurbulent  = "flat-plate-turbulent"
+	CylinderLeadingEdge = "cylinder-leading-edge"
+)
+
+var registry = map[string]Correlation{
+	IvanovBlade: NewPowerLaw(
+		IvanovBlade, "средняя теплоотдача на профиле лопатки (Иванов)",
+		0.079, 0.68, 0, 1e4, 2e6,
+	),
+	DittusBoelter: NewPowerLaw(
+		DittusBoelter, "Диттус-Болтер (турбулентное течение в канале)",
+		0.023, 0.8, 0.4, 1e4, 1.2e5,
+	),
+	Gnielinski: NewGnielinski(),
+	FlatPlateLaminar: NewPowerLaw(
+		FlatPlateLaminar, "пластина, ламинарный пограничный слой",
+		0.664, 0.5, 1./3, 0, 5e5,
+	),
+	FlatPlateTurbulent: NewPowerLaw(
+		FlatPlateTurbulent, "пластина, турбулентный пограничный слой",
+		0.037, 0.8, 1./3, 5e5, 1e7,
+	),
+	CylinderLeadingEdge: NewPowerLaw(
+		CylinderLeadingEdge, "входная кромка (лобовая точка цилиндра)",
+		1.14, 0.5, 0.4, 0, 1e5,
+	),
+}
+
+func Register(c Correlation) error {
+	if _, ok := registry[c.Name()]; ok {
+		return fmt.Errorf("correlation %s already registered", c.Name())
+	}
+	registry[c.Name()] = c
+	return nil
+}
+
+func Get(name string) (Correlation, error) {
+	if c, ok := registry[name]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown correlation %s", name)
+}
+
+func Names() []string {
+	var result = make([]string, 0, len(registry))
+	for name := range registry {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,100 @@
+package cooling
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/cooling/integration"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/ode"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/ode/forward"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
+	"github.com/Sovianum/turbocycle/utils/turbine/geom"
+)
+
+const (
+	slitEventPrecision = 1e-9
+	slitEventLengthN   = 100
+)
+
+// NewODESolver оборачивает интеграторы пакета integration в интерфейс решателя температурных систем.
+// events задаются в параметрической координате сегмента профиля. Для метода euler возвращается
+// исходный решатель turbocycle, чтобы результаты по умолчанию совпадали с прежними (щели при этом
+// не обрывают шаг интегрирования).
+func NewODESolver(conf integration.Config, events []float64) (ode.Solver, error) {
+	if _, err := integration.New(conf, events); err != nil {
+		return nil, err
+	}
+	if conf.Method == integration.Euler {
+		return forward.NewEulerSolver(), nil
+	}
+	return &odeSolver{conf: conf, events: events}, nil
+}
+
+type odeSolver struct {
+	conf   integration.Config
+	events []float64
+}
+
+// Step выполняет один шаг метода rk4. Для метода rk45 шаг при необходимости дробится
+// адаптивным интегратором так, чтобы оценка локальной погрешности не превышала допуск.
+func (s *odeSolver) Step(derivative ode.DerivativeFunc, x0, y0, step float64) (float64, error) {
+	if s.conf.Method != integration.RK45 {
+		return integration.RK4Step(integration.Func(derivative), x0, y0, step), nil
+	}
+	var conf = s.conf
+	conf.Step = step
+	var integrator, err = integration.New(conf, s.events)
+	if err != nil {
+		return 0, err
+	}
+	result, err := integrator.Integrate(integration.Func(derivative), x0, y0, x0+step)
+	if err != nil {
+		return 0, err
+	}
+	return result.Y[len(result.Y)-1], nil
+}
+
+func (s *odeSolver) Solution(derivative ode.DerivativeFunc, x0, y0, xMax, step float64) (ode.Solution, error) {
+	var conf = s.conf
+	if conf.Step <= 0 {
+		conf.Step = step
+	}
+	var integrator, err = integration.New(conf, s.events)
+	if err != nil {
+		return nil, err
+	}
+	result, err := integrator.Integrate(integration.Func(derivative), x0, y0, xMax)
+	if err != nil {
+		return nil, err
+	}
+	return &odeSolution{xArr: result.X, yArr: result.Y}, nil
+}
+
+type odeSolution struct {
+	xArr []float64
+	yArr []float64
+}
+
+func (s *odeSolution) Build() ([]float64, []float64) {
+	return s.xArr, s.yArr
+}
+
+// SlitEvents переводит координаты щелей (длина дуги от начала сегмента)
+// в параметрическую координату сегмента, в которой интегрируется система
+func SlitEvents(segment geom.Segment, slitInfoArray []profile.SlitInfo) []float64 {
+	var totalLength = geom.ApproxLength(segment, 0, 1, slitEventLengthN)
+	var result = make([]float64, 0, len(slitInfoArray))
+	for _, slit := range slitInfoArray {
+		if slit.Coord <= 0 || slit.Coord >= totalLength {
+			continue
+		}
+		var tMin, tMax = 0., 1.
+		for tMax-tMin > slitEventPrecision {
+			var t = (tMin + tMax) / 2
+			if geom.ApproxLength(segment, 0, t, slitEventLengthN) < slit.Coord {
+				tMin = t
+			} else {
+				tMax = t
+			}
+		}
+		result = append(result, (tMin+tMax)/2)
+	}
+	return result
+}
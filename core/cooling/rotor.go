@@ -0,0 +1,206 @@
+package cooling
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/cooling/integration"
+	"github.com/Sovianum/cooling-course-project/core/cooling/nusselt"
+	"github.com/Sovianum/turbocycle/impl/engine/states"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/material/gases"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/gap"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
+	"github.com/Sovianum/turbocycle/utils/turbine/geom"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
+	"math"
+)
+
+const (
+	pCoolerRootRel = 0.95 // отношение давления охладителя в корне лопатки к относительному давлению газа
+)
+
+// RotorGasState описывает параметры газа в относительном движении в сечении рабочей лопатки
+type RotorGasState struct {
+	HRel   float64
+	Radius float64
+	W      float64 // относительная скорость на входе в решетку
+	TStagW float64 // температура торможения в относительном движении
+	PStagW float64 // давление торможения в относительном движении
+}
+
+func NewRotorGasState(
+	stage turbine.StageNode,
+	profiler profilers.Profiler,
+	hRel float64,
+) (RotorGasState, error) {
+	var pack = stage.GetDataPack()
+	if pack.Err != nil {
+		return RotorGasState{}, pack.Err
+	}
+	var gas = stage.GasInput().GetState().(states.GasPortState).Gas
+	var w = profiler.InletTriangle(hRel).W()
+	var k = gases.K(gas, pack.T1)
+	var tStagW = pack.T1 + w*w/(2*gas.Cp(pack.T1))
+
+	return RotorGasState{
+		HRel:   hRel,
+		Radius: rotorRadius(pack.StageGeometry.RotorGeometry(), hRel),
+		W:      w,
+		TStagW: tStagW,
+		PStagW: pack.P1 * math.Pow(tStagW/pack.T1, k/(k-1)),
+	}, nil
+}
+
+// RotorPumping описывает изменение параметров охладителя во вращающихся каналах лопатки
+// от корневого сечения до сечения радиуса r (сохранение ротальпии, изотермическое сжатие).
+type RotorPumping struct {
+	Omega float64
+	RRoot float64
+	Cp    float64
+	R     float64
+}
+
+func NewRotorPumping(stage turbine.StageNode) (RotorPumping, error) {
+	var pack = stage.GetDataPack()
+	if pack.Err != nil {
+		return RotorPumping{}, pack.Err
+	}
+	var air = gases.GetAir()
+	return RotorPumping{
+		Omega: pack.RPM * math.Pi / 30,
+		RRoot: rotorRadius(pack.StageGeometry.RotorGeometry(), 0),
+		Cp:    air.Cp(TCoolerInlet),
+		R:     air.R(),
+	}, nil
+}
+
+// RotorCoolerState описывает параметры охладителя в каналах рабочей лопатки в сечении hRel
+type RotorCoolerState struct {
+	HRel float64
+	T    float64
+	P    float64
+}
+
+// NewRotorCoolerState рассчитывает параметры охладителя по его температуре tRoot в корне лопатки.
+// Давление охладителя в корне задается относительно давления торможения газа в относительном движении
+// в корневом сечении и не зависит от рассматриваемого сечения.
+func NewRotorCoolerState(
+	stage turbine.StageNode,
+	profiler profilers.Profiler,
+	tRoot float64,
+	hRel float64,
+) (RotorCoolerState, error) {
+	rootState, err := NewRotorGasState(stage, profiler, 0)
+	if err != nil {
+		return RotorCoolerState{}, err
+	}
+	pumping, err := NewRotorPumping(stage)
+	if err != nil {
+		return RotorCoolerState{}, err
+	}
+	var r = rotorRadius(stage.GetDataPack().StageGeometry.RotorGeometry(), hRel)
+	return RotorCoolerState{
+		HRel: hRel,
+		T:    pumping.CoolerTemperature(tRoot, r),
+		P:    pumping.CoolerPressure(rootState.PStagW*pCoolerRootRel, tRoot, r),
+	}, nil
+}
+
+func (p RotorPumping) TemperatureRise(r float64) float64 {
+	return p.Omega * p.Omega * (r*r - p.RRoot*p.RRoot) / (2 * p.Cp)
+}
+
+func (p RotorPumping) PressureRatio(r, t float64) float64 {
+	return math.Exp(p.Omega * p.Omega * (r*r - p.RRoot*p.RRoot) / (2 * p.R * t))
+}
+
+func (p RotorPumping) CoolerTemperature(tRoot, r float64) float64 {
+	return tRoot + p.TemperatureRise(r)
+}
+
+func (p RotorPumping) CoolerPressure(pRoot, tRoot, r float64) float64 {
+	var tMean = tRoot + p.TemperatureRise(r)/2
+	return pRoot * p.PressureRatio(r, tMean)
+}
+
+func GetInitedRotorGapCalculator(
+	stage turbine.StageNode,
+	profiler profilers.Profiler,
+	profile profiles.BladeProfile,
+	correlation nusselt.Correlation,
+	hRel float64,
+) (gap.GapCalculator, error) {
+	var dataPack = stage.GetDataPack()
+	if dataPack.Err != nil {
+		return nil, dataPack.Err
+	}
+	gasState, err := NewRotorGasState(stage, profiler, hRel)
+	if err != nil {
+		return nil, err
+	}
+	pumping, err := NewRotorPumping(stage)
+	if err != nil {
+		return nil, err
+	}
+
+	var gas = stage.GasInput().GetState().(states.GasPortState).Gas
+	return gap.NewGapCalculator(
+		gases.GetAir(), gas,
+		gasState.W, gasState.PStagW,
+		dataPack.StageGeometry.RotorGeometry(),
+		profile,
+		WallThk,
+		LambdaM,
+		nusselt.ReLaw(correlation, PrGas),
+		gasState.TStagW,
+		tWallOuter,
+		pumping.CoolerTemperature(TCoolerInlet, gasState.Radius),
+	), nil
+}
+
+func GetInitedRotorConvTemperatureSystem(
+	airMassRate float64,
+	stage turbine.StageNode,
+	profiler profilers.Profiler,
+	segment geom.Segment,
+	alphaAirFunc cooling.AlphaLaw,
+	alphaGasFunc cooling.AlphaLaw,
+	odeConf integration.Config,
+	hRel float64,
+) (profile.TemperatureSystem, error) {
+	gasState, err := NewRotorGasState(stage, profiler, hRel)
+	if err != nil {
+		return nil, err
+	}
+
+	solver, err := NewODESolver(odeConf, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.NewConvectiveTemperatureSystem(
+		solver,
+		airMassRate,
+		gases.GetAir().Cp,
+		func(x float64) float64 {
+			return gasState.TStagW
+		},
+		alphaAirFunc,
+		alphaGasFunc,
+		func(x float64) float64 {
+			return WallThk
+		},
+		func(t float64) float64 {
+			return LambdaM
+		},
+		segment,
+	), nil
+}
+
+func rotorRadius(bladingGeom geometry.BladingGeometry, hRel float64) float64 {
+	var x = bladingGeom.XBladeOut() / 2
+	var rIn = bladingGeom.InnerProfile().Diameter(x) / 2
+	var rOut = bladingGeom.OuterProfile().Diameter(x) / 2
+	return rIn + (rOut-rIn)*hRel
+}
@@ -0,0 +1,27 @@
+package cooling
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestRotorPumping(t *testing.T) {
+	var pumping = RotorPumping{
+		Omega: 1000,
+		RRoot: 0.3,
+		Cp:    1005,
+		R:     287,
+	}
+	assert.InDelta(t, 0, pumping.TemperatureRise(pumping.RRoot), 1e-9)
+	assert.InDelta(t, 1, pumping.PressureRatio(pumping.RRoot, 500), 1e-9)
+
+	var r = 0.35
+	var expectedRise = 1000. * 1000. * (r*r - 0.3*0.3) / (2 * 1005)
+	assert.InDelta(t, expectedRise, pumping.TemperatureRise(r), 1e-9)
+	assert.InDelta(t, 500+expectedRise, pumping.CoolerTemperature(500, r), 1e-9)
+
+	var tMean = 500 + expectedRise/2
+	var expectedRatio = math.Exp(1000. * 1000. * (r*r - 0.3*0.3) / (2 * 287 * tMean))
+	assert.InDelta(t, 2e6*expectedRatio, pumping.CoolerPressure(2e6, 500, r), 1e-3)
+}
@@ -1,11 +1,11 @@
 package cooling
 
 import (
+	"github.com/Sovianum/cooling-course-project/core/cooling/integration"
 	"github.com/Sovianum/turbocycle/impl/engine/states"
 	"github.com/Sovianum/turbocycle/impl/stage/turbine"
 	"github.com/Sovianum/turbocycle/material/gases"
 	"github.com/Sovianum/turbocycle/utils/turbine/cooling"
-	"github.com/Sovianum/turbocycle/utils/turbine/cooling/ode/forward"
 	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
 	"github.com/Sovianum/turbocycle/utils/turbine/geom"
 	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
@@ -17,6 +17,7 @@ func GetInitedStatorConvTemperatureSystem(
 	segment geom.Segment,
 	alphaAirFunc cooling.AlphaLaw,
 	alphaGasFunc cooling.AlphaLaw,
+	odeConf integration.Config,
 ) (profile.TemperatureSystem, error) {
 	var dataPack = stage.GetDataPack()
 	if dataPack.Err != nil {
@@ -24,8 +25,13 @@ func GetInitedStatorConvTemperatureSystem(
 	}
 	var tGas = stage.TemperatureInput().GetState().(states.TemperaturePortState).TStag
 
+	solver, err := NewODESolver(odeConf, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	return profile.NewConvectiveTemperatureSystem(
-		forward.NewEulerSolver(),
+		solver,
 		airMassRate,
 		gases.GetAir().Cp,
 		func(x float64) float64 {
@@ -34,10 +40,10 @@ func GetInitedStatorConvTemperatureSystem(
 		alphaAirFunc,
 		alphaGasFunc,
 		func(x float64) float64 {
-			return wallThk
+			return WallThk
 		},
 		func(t float64) float64 {
-			return lambdaM
+			return LambdaM
 		},
 		segment,
 	), nil
@@ -51,6 +57,7 @@ func GetInitedStatorConvFilmTemperatureSystem(
 	alphaGasFunc cooling.AlphaLaw,
 	law cooling.LambdaLaw,
 	slitInfoArray []profile.SlitInfo,
+	odeConf integration.Config,
 ) (profile.TemperatureSystem, error) {
 	var dataPack = stage.GetDataPack()
 	if dataPack.Err != nil {
@@ -60,8 +67,13 @@ func GetInitedStatorConvFilmTemperatureSystem(
 	var tGas = stage.TemperatureInput().GetState().(states.TemperaturePortState).TStag
 	var pGas = stage.PressureInput().GetState().(states.PressurePortState).PStag
 
+	solver, err := NewODESolver(odeConf, SlitEvents(segment, slitInfoArray))
+	if err != nil {
+		return nil, err
+	}
+
 	return profile.NewConvFilmTemperatureSystem(
-		forward.NewEulerSolver(),
+		solver,
 		coolerMassRate0,
 		gases.GetAir(), gas,
 		func(x float64) float64 {
@@ -78,10 +90,10 @@ func GetInitedStatorConvFilmTemperatureSystem(
 		alphaAirFunc, alphaGasFunc,
 		slitInfoArray,
 		func(x float64) float64 {
-			return wallThk
+			return WallThk
 		},
 		func(t float64) float64 {
-			return lambdaM
+			return LambdaM
 		},
 		segment,
 	), nil
@@ -0,0 +1,205 @@
+package flowpath
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	RowSegment  = "row"
+	GapSegment  = "gap"
+	DuctSegment = "duct"
+)
+
+// Row - венец с осевым зазором за ним. Диаметры втулки и периферии заданы
+// на входе в венец, на выходе из венца и на выходе из зазора.
+type Row struct {
+	Name      string
+	XBladeOut float64
+	XGapOut   float64
+	DHub      [3]float64
+	DTip      [3]float64
+}
+
+// Component - лопаточная машина (каскад венцов), например, КНД или ТВД
+type Component struct {
+	Name string
+	Rows []Row
+}
+
+type Config struct {
+	DuctLengthRel float64 // длина переходного канала, отнесенная к средней высоте на входе и выходе
+	MaxAreaRatio  float64 // предельная степень диффузорности канала F_вых / F_вх
+	MaxDivergence float64 // предельный эквивалентный угол раскрытия, рад
+}
+
+type Segment struct {
+	Name      string
+	Kind      string
+	Component string
+
+	X0      float64
+	X1      float64
+	DHubIn  float64
+	DHubOut float64
+	DTipIn  float64
+	DTipOut float64
+}
+
+func (s Segment) Length() float64 {
+	return s.X1 - s.X0
+}
+
+func (s Segment) AreaIn() float64 {
+	return annulusArea(s.DHubIn, s.DTipIn)
+}
+
+func (s Segment) AreaOut() float64 {
+	return annulusArea(s.DHubOut, s.DTipOut)
+}
+
+func (s Segment) AreaRatio() float64 {
+	return s.AreaOut() / s.AreaIn()
+}
+
+// DivergenceAngle - половинный угол раскрытия эквивалентного конического диффузора
+func (s Segment) DivergenceAngle() float64 {
+	if s.Length() <= 0 {
+		return 0
+	}
+	var rIn = math.Sqrt(s.AreaIn() / math.Pi)
+	var rOut = math.Sqrt(s.AreaOut() / math.Pi)
+	return math.Atan((rOut - rIn) / s.Length())
+}
+
+func (s Segment) HubAngle() float64 {
+	return wallAngle(s.DHubIn, s.DHubOut, s.Length())
+}
+
+func (s Segment) TipAngle() float64 {
+	return wallAngle(s.DTipIn, s.DTipOut, s.Length())
+}
+
+type Warning struct {
+	Segment Segment
+	Message string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s (%s): %s", w.Segment.Name, w.Segment.Kind, w.Message)
+}
+
+type FlowPath struct {
+	Segments []Segment
+	Warnings []Warning
+}
+
+func (fp FlowPath) HubLine() [][]float64 {
+	return fp.line(func(s Segment) (float64, float64) { return s.DHubIn / 2, s.DHubOut / 2 })
+}
+
+func (fp FlowPath) CasingLine() [][]float64 {
+	return fp.line(func(s Segment) (float64, float64) { return s.DTipIn / 2, s.DTipOut / 2 })
+}
+
+func (fp FlowPath) Ducts() []Segment {
+	var result []Segment
+	for _, s := range fp.Segments {
+		if s.Kind == DuctSegment {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func (fp FlowPath) line(radii func(s Segment) (float64, float64)) [][]float64 {
+	var result [][]float64
+	for i, s := range fp.Segments {
+		var rIn, rOut = radii(s)
+		if i == 0 {
+			result = append(result, []float64{s.X0, rIn})
+		}
+		result = append(result, []float64{s.X1, rOut})
+	}
+	return result
+}
+
+// Build собирает проточную часть из последовательности машин, соединяя их
+// переходными каналами, и проверяет каналы и зазоры на диффузорность.
+func Build(components []Component, conf Config) (FlowPath, error) {
+	var fp FlowPath
+	var x = 0.
+	for i, component := range components {
+		if len(component.Rows) == 0 {
+			return FlowPath{}, fmt.Errorf("component %s has no rows", component.Name)
+		}
+		if i > 0 {
+			var prev = fp.Segments[len(fp.Segments)-1]
+			var first = component.Rows[0]
+			var hMean = (prev.DTipOut - prev.DHubOut + first.DTip[0] - first.DHub[0]) / 4
+			var duct = Segment{
+				Name:    fmt.Sprintf("%s-%s", components[i-1].Name, component.Name),
+				Kind:    DuctSegment,
+				X0:      x,
+				X1:      x + conf.DuctLengthRel*hMean,
+				DHubIn:  prev.DHubOut,
+				DHubOut: first.DHub[0],
+				DTipIn:  prev.DTipOut,
+				DTipOut: first.DTip[0],
+			}
+			fp.Segments = append(fp.Segments, duct)
+			x = duct.X1
+		}
+
+		for _, row := range component.Rows {
+			fp.Segments = append(fp.Segments, Segment{
+				Name: row.Name, Kind: RowSegment, Component: component.Name,
+				X0: x, X1: x + row.XBladeOut,
+				DHubIn: row.DHub[0], DHubOut: row.DHub[1],
+				DTipIn: row.DTip[0], DTipOut: row.DTip[1],
+			})
+			if row.XGapOut > row.XBladeOut {
+				fp.Segments = append(fp.Segments, Segment{
+					Name: row.Name, Kind: GapSegment, Component: component.Name,
+					X0: x + row.XBladeOut, X1: x + row.XGapOut,
+					DHubIn: row.DHub[1], DHubOut: row.DHub[2],
+					DTipIn: row.DTip[1], DTipOut: row.DTip[2],
+				})
+			}
+			x += math.Max(row.XBladeOut, row.XGapOut)
+		}
+	}
+
+	for _, s := range fp.Segments {
+		if s.Kind == RowSegment {
+			continue
+		}
+		if conf.MaxAreaRatio > 0 && s.AreaRatio() > conf.MaxAreaRatio {
+			fp.Warnings = append(fp.Warnings, Warning{
+				Segment: s,
+				Message: fmt.Sprintf("area ratio %.3f exceeds limit %.3f", s.AreaRatio(), conf.MaxAreaRatio),
+			})
+		}
+		if conf.MaxDivergence > 0 && s.DivergenceAngle() > conf.MaxDivergence {
+			fp.Warnings = append(fp.Warnings, Warning{
+				Segment: s,
+				Message: fmt.Sprintf(
+					"divergence angle %.2f deg exceeds limit %.2f deg",
+					s.DivergenceAngle()*180/math.Pi, conf.MaxDivergence*180/math.Pi,
+				),
+			})
+		}
+	}
+	return fp, nil
+}
+
+func annulusArea(dHub, dTip float64) float64 {
+	return math.Pi * (dTip*dTip - dHub*dHub) / 4
+}
+
+func wallAngle(dIn, dOut, length float64) float64 {
+	if length <= 0 {
+		return 0
+	}
+	return math.Atan((dOut - dIn) / 2 / length)
+}
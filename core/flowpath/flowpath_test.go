@@ -0,0 +1,52 @@
+package flowpath
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func row(name string, dHub, dTip float64) Row {
+	return Row{
+		Name:      name,
+		XBladeOut: 0.04,
+		XGapOut:   0.05,
+		DHub:      [3]float64{dHub, dHub, dHub},
+		DTip:      [3]float64{dTip, dTip, dTip},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	var components = []Component{
+		{Name: "ТВД", Rows: []Row{row("СА1", 0.5, 0.6), row("РК1", 0.5, 0.6)}},
+		{Name: "ТНД", Rows: []Row{row("СА1", 0.5, 0.7)}},
+	}
+	var fp, err = Build(components, Config{
+		DuctLengthRel: 4,
+		MaxAreaRatio:  1.5,
+		MaxDivergence: 5 * math.Pi / 180,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, fp.Segments, 7)
+
+	var ducts = fp.Ducts()
+	assert.Len(t, ducts, 1)
+	var duct = ducts[0]
+	assert.InDelta(t, 0.1, duct.X0, 1e-12)
+	assert.InDelta(t, 4*(0.05+0.1)/2, duct.Length(), 1e-12)
+	assert.InDelta(t, (0.49-0.25)/(0.36-0.25), duct.AreaRatio(), 1e-12)
+	assert.InDelta(t, 0, duct.HubAngle(), 1e-12)
+
+	// площадь растет более чем в 2 раза - ожидается предупреждение о степени диффузорности
+	assert.Len(t, fp.Warnings, 2)
+
+	var hub, casing = fp.HubLine(), fp.CasingLine()
+	assert.Len(t, hub, len(fp.Segments)+1)
+	assert.InDelta(t, 0.35, casing[len(casing)-1][1], 1e-12)
+	assert.InDelta(t, duct.X1+0.05, hub[len(hub)-1][0], 1e-12)
+}
+
+func TestBuild_Empty(t *testing.T) {
+	var _, err = Build([]Component{{Name: "КНД"}}, Config{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,47 @@
+package flowpath
+
+import (
+	"fmt"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+)
+
+func NewRow(name string, bladingGeom geometry.BladingGeometry) Row {
+	var xBladeOut = bladingGeom.XBladeOut()
+	var xGapOut = bladingGeom.XGapOut()
+	var inner, outer = bladingGeom.InnerProfile(), bladingGeom.OuterProfile()
+	return Row{
+		Name:      name,
+		XBladeOut: xBladeOut,
+		XGapOut:   xGapOut,
+		DHub:      [3]float64{inner.Diameter(0), inner.Diameter(xBladeOut), inner.Diameter(xGapOut)},
+		DTip:      [3]float64{outer.Diameter(0), outer.Diameter(xBladeOut), outer.Diameter(xGapOut)},
+	}
+}
+
+// CompressorComponent возвращает венцы компрессора в порядке РК - НА
+func CompressorComponent(name string, node compressor.StagedCompressorNode) Component {
+	var result = Component{Name: name}
+	for i, stage := range node.Stages() {
+		var stageGeom = stage.GetDataPack().StageGeometry
+		result.Rows = append(result.Rows,
+			NewRow(fmt.Sprintf("%s РК%d", name, i+1), stageGeom.RotorGeometry()),
+			NewRow(fmt.Sprintf("%s НА%d", name, i+1), stageGeom.StatorGeometry()),
+		)
+	}
+	return result
+}
+
+// TurbineComponent возвращает венцы турбины в порядке СА - РК
+func TurbineComponent(name string, node turbine.StagedTurbineNode) Component {
+	var result = Component{Name: name}
+	for i, stage := range node.Stages() {
+		var stageGeom = stage.GetDataPack().StageGeometry
+		result.Rows = append(result.Rows,
+			NewRow(fmt.Sprintf("%s СА%d", name, i+1), stageGeom.StatorGeometry()),
+			NewRow(fmt.Sprintf("%s РК%d", name, i+1), stageGeom.RotorGeometry()),
+		)
+	}
+	return result
+}
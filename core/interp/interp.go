@@ -0,0 +1,27 @@
+package interp
+
+import (
+	"math"
+	"sort"
+)
+
+// Linear - линейная интерполяция табличной функции с постоянной экстраполяцией за пределами таблицы.
+// Значения xArr должны возрастать.
+func Linear(xArr, yArr []float64, x float64) float64 {
+	var n = len(xArr)
+	if n == 0 {
+		return math.NaN()
+	}
+	if x <= xArr[0] {
+		return yArr[0]
+	}
+	if x >= xArr[n-1] {
+		return yArr[n-1]
+	}
+	var i = sort.SearchFloat64s(xArr, x)
+	var x0, x1 = xArr[i-1], xArr[i]
+	if x1 == x0 {
+		return yArr[i]
+	}
+	return yArr[i-1] + (yArr[i]-yArr[i-1])*(x-x0)/(x1-x0)
+}
@@ -0,0 +1,16 @@
+package interp
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestLinear(t *testing.T) {
+	var xArr, yArr = []float64{0, 1, 2}, []float64{1, 2, 1}
+	assert.InDelta(t, 1.5, Linear(xArr, yArr, 0.5), 1e-9)
+	assert.InDelta(t, 1.5, Linear(xArr, yArr, 1.5), 1e-9)
+	assert.InDelta(t, 1, Linear(xArr, yArr, -1), 1e-9)
+	assert.InDelta(t, 1, Linear(xArr, yArr, 3), 1e-9)
+	assert.True(t, math.IsNaN(Linear(nil, nil, 0)))
+}
@@ -0,0 +1,185 @@
+package life
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	MaxCreepLife = 1e7 // ч, ограничение сверху для оценки долговечности
+	MaxLCFCycles = 1e7 // ограничение сверху для оценки числа циклов
+
+	mansonElasticCoef = 3.5
+	mansonElasticExp  = -0.12
+	mansonPlasticExp  = -0.6
+)
+
+type Config struct {
+	Material   Material
+	AreaTaper  float64 // отношение площади сечения на периферии к площади корневого сечения
+	SectionNum int
+
+	RequiredLife   float64 // требуемый ресурс, ч
+	RequiredCycles float64 // требуемое число пусков
+}
+
+type Section struct {
+	HRel   float64 // относительная высота сечения
+	Radius float64 // радиус сечения, м
+	TWall  float64 // максимальная температура стенки, К
+	DTWall float64 // перепад температур по сечению, К
+}
+
+type SectionLife struct {
+	Section
+
+	Stress        float64 // напряжение растяжения от центробежных сил, Па
+	ThermalStress float64 // температурное напряжение, Па
+	LMP           float64 // параметр Ларсона-Миллера
+	CreepLife     float64 // время до разрушения, ч
+	StrainRange   float64 // размах деформаций за цикл
+	LCFCycles     float64 // число циклов до разрушения
+}
+
+type Result struct {
+	Material string
+	RPM      float64
+	Sections []SectionLife
+
+	MinCreepLife float64
+	MinCreepHRel float64
+	MinLCFCycles float64
+	MinLCFHRel   float64
+
+	RequiredLife   float64
+	RequiredCycles float64
+	CreepPassed    bool
+	LCFPassed      bool
+}
+
+func (r Result) Passed() bool {
+	return r.CreepPassed && r.LCFPassed
+}
+
+func Assess(conf Config, rpm float64, sections []Section) (Result, error) {
+	if err := conf.Material.Validate(); err != nil {
+		return Result{}, err
+	}
+	if len(sections) < 2 {
+		return Result{}, fmt.Errorf("at least two sections required")
+	}
+
+	var radii = make([]float64, len(sections))
+	var areas = make([]float64, len(sections))
+	for i, s := range sections {
+		radii[i] = s.Radius
+		areas[i] = 1 - (1-conf.AreaTaper)*s.HRel
+	}
+	var omega = rpm * math.Pi / 30
+	var stresses = CentrifugalStress(conf.Material.Density, omega, radii, areas)
+
+	var result = Result{
+		Material:       conf.Material.Name,
+		RPM:            rpm,
+		Sections:       make([]SectionLife, len(sections)),
+		MinCreepLife:   math.Inf(1),
+		MinLCFCycles:   math.Inf(1),
+		RequiredLife:   conf.RequiredLife,
+		RequiredCycles: conf.RequiredCycles,
+	}
+	for i, s := range sections {
+		var sl = SectionLife{Section: s, Stress: stresses[i]}
+		if sl.Stress > 0 {
+			sl.LMP = LarsonMillerParameter(conf.Material, sl.Stress)
+			sl.CreepLife = CreepLife(conf.Material, sl.LMP, s.TWall)
+		} else {
+			// периферийное сечение не нагружено центробежными силами
+			sl.CreepLife = MaxCreepLife
+		}
+		sl.ThermalStress = ThermalStress(conf.Material, s.DTWall)
+		sl.StrainRange = ThermalStrainRange(conf.Material, s.DTWall)
+		sl.LCFCycles = LCFCycles(conf.Material, sl.StrainRange)
+		result.Sections[i] = sl
+
+		if sl.CreepLife < result.MinCreepLife {
+			result.MinCreepLife = sl.CreepLife
+			result.MinCreepHRel = s.HRel
+		}
+		if sl.LCFCycles < result.MinLCFCycles {
+			result.MinLCFCycles = sl.LCFCycles
+			result.MinLCFHRel = s.HRel
+		}
+	}
+	result.CreepPassed = result.MinCreepLife >= conf.RequiredLife
+	result.LCFPassed = result.MinLCFCycles >= conf.RequiredCycles
+	return result, nil
+}
+
+// CentrifugalStress возвращает напряжения растяжения в сечениях лопатки,
+// радиусы которых заданы по возрастанию от корня к периферии.
+func CentrifugalStress(density, omega float64, radii, areas []float64) []float64 {
+	var n = len(radii)
+	var result = make([]float64, n)
+	var force = 0.
+	for i := n - 2; i >= 0; i-- {
+		var f1 = areas[i] * radii[i]
+		var f2 = areas[i+1] * radii[i+1]
+		force += (f1 + f2) / 2 * (radii[i+1] - radii[i])
+		result[i] = density * omega * omega * force / areas[i]
+	}
+	return result
+}
+
+func LarsonMillerParameter(m Material, stress float64) float64 {
+	var logSigma = math.Log10(stress)
+	var n = len(m.LMPArr)
+
+	var i = 0
+	for i < n-2 && m.LMSigmaArr[i+1] > stress {
+		i++
+	}
+	var ls1, ls2 = math.Log10(m.LMSigmaArr[i]), math.Log10(m.LMSigmaArr[i+1])
+	return m.LMPArr[i] + (logSigma-ls1)/(ls2-ls1)*(m.LMPArr[i+1]-m.LMPArr[i])
+}
+
+func CreepLife(m Material, lmp, t float64) float64 {
+	var logLife = lmp/(t*lmpFactor) - m.LMC
+	return math.Min(math.Pow(10, logLife), MaxCreepLife)
+}
+
+func ThermalStrainRange(m Material, dt float64) float64 {
+	return m.Alpha * math.Abs(dt) / (1 - m.Poisson)
+}
+
+func ThermalStress(m Material, dt float64) float64 {
+	return m.E * ThermalStrainRange(m, dt) / 2
+}
+
+// LCFCycles решает уравнение универсальных наклонов Мэнсона
+// относительно числа циклов до разрушения.
+func LCFCycles(m Material, strainRange float64) float64 {
+	var ductility = math.Log(1 / (1 - m.Reduction))
+	var strainFunc = func(logN float64) float64 {
+		var n = math.Pow(10, logN)
+		return mansonElasticCoef*m.SigmaU/m.E*math.Pow(n, mansonElasticExp) +
+			math.Pow(ductility, 0.6)*math.Pow(n, mansonPlasticExp)
+	}
+
+	var logMax = math.Log10(MaxLCFCycles)
+	if strainFunc(logMax) >= strainRange {
+		return MaxLCFCycles
+	}
+	var left, right = 0., logMax
+	if strainFunc(left) <= strainRange {
+		return 1
+	}
+	for right-left > 1e-6 {
+		var mid = (left + right) / 2
+		if strainFunc(mid) > strainRange {
+			left = mid
+		} else {
+			right = mid
+		}
+	}
+	return math.Pow(10, (left+right)/2)
+}
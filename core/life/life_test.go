@@ -0,0 +1,61 @@
+package life
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestCentrifugalStress_ConstantArea(t *testing.T) {
+	var density = 8e3
+	var omega = 1000.
+	var radii = []float64{0.2, 0.225, 0.25, 0.275, 0.3}
+	var areas = []float64{1, 1, 1, 1, 1}
+
+	var stress = CentrifugalStress(density, omega, radii, areas)
+	var expected = density * omega * omega * (0.3*0.3 - 0.2*0.2) / 2
+	assert.InDelta(t, expected, stress[0], expected*1e-6)
+	assert.InDelta(t, 0, stress[len(stress)-1], 1e-9)
+}
+
+func TestLarsonMillerParameter_TablePoints(t *testing.T) {
+	var m, err = GetMaterial(ZhS6K)
+	assert.NoError(t, err)
+	for i, sigma := range m.LMSigmaArr {
+		assert.InDelta(t, m.LMPArr[i], LarsonMillerParameter(m, sigma), 1e-9)
+	}
+}
+
+func TestCreepLife_Consistency(t *testing.T) {
+	var m, _ = GetMaterial(ZhS30)
+	var tWall = 1100.
+	var life = CreepLife(m, 24, tWall)
+	assert.InDelta(t, 24, tWall*(m.LMC+math.Log10(life))*lmpFactor, 1e-9)
+}
+
+func TestLCFCycles_Monotonic(t *testing.T) {
+	var m, _ = GetMaterial(IN738LC)
+	var n1 = LCFCycles(m, ThermalStrainRange(m, 100))
+	var n2 = LCFCycles(m, ThermalStrainRange(m, 300))
+	assert.True(t, n1 > n2)
+	assert.InDelta(t, MaxLCFCycles, LCFCycles(m, 0), 1e-9)
+}
+
+func TestAssess(t *testing.T) {
+	var m, _ = GetMaterial(ZhS6K)
+	var conf = Config{Material: m, AreaTaper: 0.5, RequiredLife: 1e3, RequiredCycles: 1e3}
+	var sections = []Section{
+		{HRel: 0, Radius: 0.25, TWall: 1100, DTWall: 150},
+		{HRel: 0.5, Radius: 0.28, TWall: 1150, DTWall: 150},
+		{HRel: 1, Radius: 0.31, TWall: 1100, DTWall: 150},
+	}
+	var result, err = Assess(conf, 12e3, sections)
+	assert.NoError(t, err)
+	assert.Len(t, result.Sections, 3)
+	assert.True(t, result.MinCreepHRel < 1)
+	assert.True(t, result.MinCreepLife < MaxCreepLife)
+
+	var tip = result.Sections[2]
+	assert.Equal(t, 0., tip.Stress)
+	assert.Equal(t, MaxCreepLife, tip.CreepLife)
+}
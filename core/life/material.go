@@ -0,0 +1,105 @@
+package life
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	ZhS6K     = "ЖС6К"
+	ZhS30     = "ЖС30"
+	IN738LC   = "IN738LC"
+	lmpFactor = 1e-3
+)
+
+type Material struct {
+	Name string
+
+	Density   float64 // плотность, кг/м^3
+	E         float64 // модуль упругости при рабочей температуре, Па
+	Alpha     float64 // коэффициент линейного расширения, 1/К
+	Poisson   float64 // коэффициент Пуассона
+	SigmaU    float64 // предел прочности, Па
+	Reduction float64 // относительное сужение при разрыве
+	TWallMax  float64 // допустимая температура стенки лопатки, К
+
+	// параметр Ларсона-Миллера P = T (C + lg t) * 10^-3, t - в часах
+	LMC        float64   // константа C
+	LMPArr     []float64 // значения параметра P (по возрастанию)
+	LMSigmaArr []float64 // соответствующие значения длительной прочности, Па
+}
+
+func (m Material) Validate() error {
+	if m.TWallMax <= 0 {
+		return fmt.Errorf("material %s: allowed wall temperature must be positive", m.Name)
+	}
+	if len(m.LMPArr) < 2 {
+		return fmt.Errorf("material %s: at least two Larson-Miller points required", m.Name)
+	}
+	if len(m.LMPArr) != len(m.LMSigmaArr) {
+		return fmt.Errorf("material %s: inconsistent Larson-Miller table", m.Name)
+	}
+	for i := 1; i != len(m.LMPArr); i++ {
+		if m.LMPArr[i] <= m.LMPArr[i-1] || m.LMSigmaArr[i] >= m.LMSigmaArr[i-1] {
+			return fmt.Errorf("material %s: Larson-Miller table must be monotonic", m.Name)
+		}
+	}
+	return nil
+}
+
+var materials = map[string]Material{
+	ZhS6K: {
+		Name:    ZhS6K,
+		Density: 8.0e3, E: 1.6e11, Alpha: 14e-6, Poisson: 0.3,
+		SigmaU: 950e6, Reduction: 0.08,
+		TWallMax:   1273,
+		LMC:        20,
+		LMPArr:     []float64{22, 24, 25.5, 27, 28.5},
+		LMSigmaArr: []float64{650e6, 450e6, 300e6, 180e6, 100e6},
+	},
+	ZhS30: {
+		Name:    ZhS30,
+		Density: 8.5e3, E: 1.65e11, Alpha: 13.5e-6, Poisson: 0.3,
+		SigmaU: 1000e6, Reduction: 0.06,
+		TWallMax:   1323,
+		LMC:        20,
+		LMPArr:     []float64{22, 24, 25.5, 27, 28.5},
+		LMSigmaArr: []float64{700e6, 480e6, 320e6, 200e6, 110e6},
+	},
+	IN738LC: {
+		Name:    IN738LC,
+		Density: 8.1e3, E: 1.6e11, Alpha: 14.5e-6, Poisson: 0.3,
+		SigmaU: 900e6, Reduction: 0.1,
+		TWallMax:   1223,
+		LMC:        20,
+		LMPArr:     []float64{22, 24, 25.5, 27, 28.5},
+		LMSigmaArr: []float64{600e6, 400e6, 250e6, 150e6, 80e6},
+	},
+}
+
+func GetMaterial(name string) (Material, error) {
+	if m, ok := materials[name]; ok {
+		return m, nil
+	}
+	return Material{}, fmt.Errorf("unknown material %s", name)
+}
+
+func RegisterMaterial(m Material) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	if _, ok := materials[m.Name]; ok {
+		return fmt.Errorf("material %s already registered", m.Name)
+	}
+	materials[m.Name] = m
+	return nil
+}
+
+func MaterialNames() []string {
+	var result = make([]string, 0, len(materials))
+	for name := range materials {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,34 @@
+package life
+
+import (
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+)
+
+func AssessRotor(
+	stage turbine.StageNode,
+	conf Config,
+	tWallFunc func(hRel float64) float64,
+	dtWallFunc func(hRel float64) float64,
+) (Result, error) {
+	var pack = stage.GetDataPack()
+	if pack.Err != nil {
+		return Result{}, pack.Err
+	}
+	var rotorGeom = pack.StageGeometry.RotorGeometry()
+	var x = rotorGeom.XBladeOut() / 2
+	var rIn = rotorGeom.InnerProfile().Diameter(x) / 2
+	var rOut = rotorGeom.OuterProfile().Diameter(x) / 2
+
+	var hRelArr = common.LinSpace(0, 1, conf.SectionNum)
+	var sections = make([]Section, len(hRelArr))
+	for i, hRel := range hRelArr {
+		sections[i] = Section{
+			HRel:   hRel,
+			Radius: rIn + (rOut-rIn)*hRel,
+			TWall:  tWallFunc(hRel),
+			DTWall: dtWallFunc(hRel),
+		}
+	}
+	return Assess(conf, pack.RPM, sections)
+}
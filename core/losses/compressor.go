@@ -0,0 +1,149 @@
+package losses
+
+import (
+	"fmt"
+	"math"
+)
+
+// CompressorRowGeometry - параметры компрессорной решетки на среднем радиусе.
+// Углы потока отсчитываются от осевого направления в системе координат венца,
+// скорости - относительные для рабочего колеса и абсолютные для направляющего аппарата.
+type CompressorRowGeometry struct {
+	InletAngle     float64
+	OutletAngle    float64
+	InletVelocity  float64
+	OutletVelocity float64
+	Solidity       float64 // b / t
+	AspectRatio    float64 // h / b
+	TipGapRel      float64 // радиальный зазор / h
+}
+
+func (g CompressorRowGeometry) Validate() error {
+	if g.Solidity <= 0 || g.AspectRatio <= 0 {
+		return fmt.Errorf("solidity and aspect ratio must be positive")
+	}
+	if g.InletVelocity <= 0 || g.OutletVelocity <= 0 {
+		return fmt.Errorf("velocities must be positive")
+	}
+	if math.Abs(g.InletAngle) >= math.Pi/2 || math.Abs(g.OutletAngle) >= math.Pi/2 {
+		return fmt.Errorf("flow angles must be in (-90, 90) deg")
+	}
+	return nil
+}
+
+// CompressorRowLoss - коэффициенты потерь полного давления, отнесенные к скоростному напору на входе
+type CompressorRowLoss struct {
+	Profile      float64
+	EndWall      float64
+	TipClearance float64
+}
+
+func (l CompressorRowLoss) Total() float64 {
+	return l.Profile + l.EndWall + l.TipClearance
+}
+
+// DiffusionLimits - предельные значения диффузорности. Нулевое значение отключает проверку.
+type DiffusionLimits struct {
+	MaxDiffusionFactor float64
+	MinDeHaller        float64
+}
+
+type DiffusionCheck struct {
+	DiffusionFactor float64
+	DeHaller        float64
+
+	DiffusionPassed bool
+	DeHallerPassed  bool
+}
+
+func (c DiffusionCheck) Passed() bool {
+	return c.DiffusionPassed && c.DeHallerPassed
+}
+
+func CheckDiffusion(g CompressorRowGeometry, limits DiffusionLimits) DiffusionCheck {
+	var result = DiffusionCheck{
+		DiffusionFactor: DiffusionFactor(g),
+		DeHaller:        DeHaller(g),
+	}
+	result.DiffusionPassed = limits.MaxDiffusionFactor == 0 || result.DiffusionFactor <= limits.MaxDiffusionFactor
+	result.DeHallerPassed = limits.MinDeHaller == 0 || result.DeHaller >= limits.MinDeHaller
+	return result
+}
+
+// DiffusionFactor - фактор диффузорности Либлейна D = 1 - w_2 / w_1 + |w_1u - w_2u| / (2 sigma w_1)
+func DiffusionFactor(g CompressorRowGeometry) float64 {
+	var w1u = g.InletVelocity * math.Sin(g.InletAngle)
+	var w2u = g.OutletVelocity * math.Sin(g.OutletAngle)
+	return 1 - g.OutletVelocity/g.InletVelocity + math.Abs(w1u-w2u)/(2*g.Solidity*g.InletVelocity)
+}
+
+// DeHaller - число де Галлера w_2 / w_1
+func DeHaller(g CompressorRowGeometry) float64 {
+	return g.OutletVelocity / g.InletVelocity
+}
+
+// CompressorRow оценивает потери в компрессорной решетке: профильные - по толщине потери импульса
+// в следе при эквивалентной диффузорности Либлейна, концевые - по Хауэллу, в радиальном зазоре -
+// пропорционально относительному зазору и нагрузке профиля
+func CompressorRow(g CompressorRowGeometry) (CompressorRowLoss, error) {
+	if err := g.Validate(); err != nil {
+		return CompressorRowLoss{}, err
+	}
+	return CompressorRowLoss{
+		Profile:      CompressorProfileLoss(g),
+		EndWall:      EndWallLoss(g),
+		TipClearance: CompressorTipClearanceLoss(g),
+	}, nil
+}
+
+// CompressorProfileLoss - профильные потери omega = 2 (theta / b) sigma / cos(b_2) (cos(b_1) / cos(b_2))^2
+func CompressorProfileLoss(g CompressorRowGeometry) float64 {
+	var cosIn, cosOut = math.Cos(g.InletAngle), math.Cos(g.OutletAngle)
+	var theta = 0.004 / (1 - 1.17*math.Log(equivalentDiffusion(g)))
+	return 2 * theta * g.Solidity / cosOut * (cosIn / cosOut) * (cosIn / cosOut)
+}
+
+// EndWallLoss - потери на стенках канала C_Da = 0.02 t / h и вторичные потери C_Ds = 0.018 C_L^2
+func EndWallLoss(g CompressorRowGeometry) float64 {
+	var cl = liftCoef(g)
+	var drag = 0.02/(g.Solidity*g.AspectRatio) + 0.018*cl*cl
+	return dragToLoss(g, drag)
+}
+
+// CompressorTipClearanceLoss - потери в радиальном зазоре C_Dk = 0.29 (k / h) C_L^1.5
+func CompressorTipClearanceLoss(g CompressorRowGeometry) float64 {
+	if g.TipGapRel <= 0 {
+		return 0
+	}
+	var drag = 0.29 * g.TipGapRel * math.Pow(math.Abs(liftCoef(g)), 1.5)
+	return dragToLoss(g, drag)
+}
+
+// StageEfficiency возвращает адиабатический КПД ступени по потерям в венцах.
+// Потери энтальпии считаются равными omega w_1^2 / 2 для рабочего колеса и omega c_2^2 / 2 для направляющего аппарата.
+func StageEfficiency(rotor, stator CompressorRowLoss, rotorGeom, statorGeom CompressorRowGeometry, labour float64) float64 {
+	var rotorLoss = rotor.Total() * rotorGeom.InletVelocity * rotorGeom.InletVelocity / 2
+	var statorLoss = stator.Total() * statorGeom.InletVelocity * statorGeom.InletVelocity / 2
+	return 1 - (rotorLoss+statorLoss)/labour
+}
+
+// equivalentDiffusion - эквивалентная диффузорность Либлейна
+func equivalentDiffusion(g CompressorRowGeometry) float64 {
+	var cosIn, cosOut = math.Cos(g.InletAngle), math.Cos(g.OutletAngle)
+	var turning = math.Tan(g.InletAngle) - math.Tan(g.OutletAngle)
+	return cosOut / cosIn * (1.12 + 0.61*cosIn*cosIn/g.Solidity*math.Abs(turning))
+}
+
+// liftCoef - коэффициент подъемной силы профиля без учета профильного сопротивления
+func liftCoef(g CompressorRowGeometry) float64 {
+	return 2 / g.Solidity * math.Cos(meanAngle(g)) * (math.Tan(g.InletAngle) - math.Tan(g.OutletAngle))
+}
+
+func dragToLoss(g CompressorRowGeometry, drag float64) float64 {
+	var cosIn, cosM = math.Cos(g.InletAngle), math.Cos(meanAngle(g))
+	return drag * g.Solidity * cosIn * cosIn / (cosM * cosM * cosM)
+}
+
+func meanAngle(g CompressorRowGeometry) float64 {
+	return math.Atan((math.Tan(g.InletAngle) + math.Tan(g.OutletAngle)) / 2)
+}
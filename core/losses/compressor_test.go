@@ -0,0 +1,103 @@
+package losses
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func compressorGeometry() CompressorRowGeometry {
+	var inlet, outlet = 60 * math.Pi / 180, 45 * math.Pi / 180
+	var ca = 150.
+	return CompressorRowGeometry{
+		InletAngle:     inlet,
+		OutletAngle:    outlet,
+		InletVelocity:  ca / math.Cos(inlet),
+		OutletVelocity: ca / math.Cos(outlet),
+		Solidity:       1.2,
+		AspectRatio:    3,
+	}
+}
+
+func TestDiffusionFactor(t *testing.T) {
+	var g = compressorGeometry()
+	var ratio = math.Cos(g.InletAngle) / math.Cos(g.OutletAngle)
+	assert.InDelta(t, ratio, DeHaller(g), 1e-9)
+
+	var expected = 1 - ratio + (math.Tan(g.InletAngle)-math.Tan(g.OutletAngle))*math.Cos(g.InletAngle)/(2*g.Solidity)
+	assert.InDelta(t, expected, DiffusionFactor(g), 1e-9)
+
+	var check = CheckDiffusion(g, DiffusionLimits{MaxDiffusionFactor: 0.6, MinDeHaller: 0.72})
+	assert.True(t, check.DiffusionPassed)
+	assert.False(t, check.DeHallerPassed)
+	assert.False(t, check.Passed())
+	assert.True(t, CheckDiffusion(g, DiffusionLimits{}).Passed())
+}
+
+func TestCompressorRow(t *testing.T) {
+	var g = compressorGeometry()
+	var loss, err = CompressorRow(g)
+	assert.NoError(t, err)
+	assert.True(t, loss.Profile > 0)
+	assert.True(t, loss.EndWall > 0)
+	assert.Equal(t, 0., loss.TipClearance)
+	assert.InDelta(t, 0.06, loss.Total(), 0.05)
+
+	g.TipGapRel = 0.02
+	withGap, _ := CompressorRow(g)
+	assert.True(t, withGap.Total() > loss.Total())
+
+	// более нагруженная решетка имеет большие потери
+	g = compressorGeometry()
+	g.OutletAngle = 35 * math.Pi / 180
+	g.OutletVelocity = g.InletVelocity * math.Cos(g.InletAngle) / math.Cos(g.OutletAngle)
+	loaded, _ := CompressorRow(g)
+	assert.True(t, loaded.Total() > loss.Total())
+
+	g.Solidity = 0
+	_, err = CompressorRow(g)
+	assert.Error(t, err)
+}
+
+func TestStageEfficiency(t *testing.T) {
+	var g = compressorGeometry()
+	var loss = CompressorRowLoss{Profile: 0.05}
+	var w2 = g.InletVelocity * g.InletVelocity / 2
+	assert.InDelta(t, 1-2*0.05*w2/30e3, StageEfficiency(loss, loss, g, g, 30e3), 1e-9)
+}
+
+// значения для решетки beta_1 = 60 град, beta_2 = 45 град, sigma = 1.2, h / b = 3, c_a = 150 м/с
+// рассчитаны вручную: w_1 = 300 м/с, w_2 = 212.13 м/с, tg beta_1 - tg beta_2 = 0.73205
+
+func TestDeHaller_HandComputed(t *testing.T) {
+	assert.InDelta(t, 0.70711, DeHaller(compressorGeometry()), 1e-5)
+}
+
+// D = 1 - 212.13 / 300 + |259.81 - 150| / (2 * 1.2 * 300) = 0.44540
+func TestDiffusionFactor_HandComputed(t *testing.T) {
+	assert.InDelta(t, 0.44540, DiffusionFactor(compressorGeometry()), 1e-5)
+}
+
+// D_eq = cos 45 / cos 60 (1.12 + 0.61 cos^2 60 / 1.2 * 0.73205) = 1.71549,
+// theta / b = 0.004 / (1 - 1.17 ln D_eq) = 0.010853, omega = 2 * 0.010853 * 1.2 / cos 45 (cos 60 / cos 45)^2
+func TestCompressorProfileLoss_HandComputed(t *testing.T) {
+	var g = compressorGeometry()
+	assert.InDelta(t, 1.71549, equivalentDiffusion(g), 1e-5)
+	assert.InDelta(t, 0.018418, CompressorProfileLoss(g), 1e-6)
+}
+
+// beta_m = 53.794 град, C_L = 2 / 1.2 cos beta_m * 0.73205 = 0.72069,
+// C_D = 0.02 / (1.2 * 3) + 0.018 C_L^2 = 0.014905, omega = C_D sigma cos^2 beta_1 / cos^3 beta_m = 1.45560 C_D
+func TestEndWallLoss_HandComputed(t *testing.T) {
+	var g = compressorGeometry()
+	assert.InDelta(t, 0.72069, liftCoef(g), 1e-5)
+	assert.InDelta(t, 0.014905*1.45560, EndWallLoss(g), 1e-6)
+}
+
+// C_Dk = 0.29 * 0.02 * 0.72069^1.5 = 0.0035486
+func TestCompressorTipClearanceLoss_HandComputed(t *testing.T) {
+	var g = compressorGeometry()
+	assert.Equal(t, 0., CompressorTipClearanceLoss(g))
+	g.TipGapRel = 0.02
+	assert.InDelta(t, 0.0035486*1.45560, CompressorTipClearanceLoss(g), 1e-6)
+}
@@ -0,0 +1,114 @@
+package losses
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func nozzleGeometry() RowGeometry {
+	return RowGeometry{
+		InletAngle:     0,
+		OutletAngle:    70 * math.Pi / 180,
+		PitchRel:       0.85,
+		AspectRatio:    2,
+		ThicknessRel:   0.2,
+		TEThicknessRel: 0.02,
+		Reynolds:       5e5,
+	}
+}
+
+func TestRow_Nozzle(t *testing.T) {
+	var loss, err = Row(nozzleGeometry())
+	assert.NoError(t, err)
+
+	// для типичной сопловой решетки коэффициент скорости лежит в диапазоне 0.94 - 0.99
+	assert.True(t, loss.Profile > 0)
+	assert.True(t, loss.Secondary > 0)
+	assert.Equal(t, 0., loss.TipClearance)
+	assert.True(t, loss.TrailingEdge > 0)
+	assert.InDelta(t, 0.965, loss.VelocityCoef(), 0.025)
+}
+
+func TestProfileLoss_OptimumPitch(t *testing.T) {
+	var g = nozzleGeometry()
+	g.PitchRel = 0.614 + 70./130
+	var opt = ProfileLoss(g)
+
+	g.PitchRel = 0.8
+	assert.True(t, ProfileLoss(g) > opt)
+	g.PitchRel = 1.5
+	assert.True(t, ProfileLoss(g) > opt)
+}
+
+func TestProfileLoss_ImpulseAboveNozzle(t *testing.T) {
+	var nozzle = nozzleGeometry()
+	nozzle.OutletAngle = 60 * math.Pi / 180
+	nozzle.PitchRel = 0.7
+
+	var impulse = nozzle
+	impulse.InletAngle = impulse.OutletAngle
+	assert.True(t, ProfileLoss(impulse) > ProfileLoss(nozzle))
+	assert.True(t, TrailingEdgeLoss(impulse) > TrailingEdgeLoss(nozzle))
+}
+
+func TestSecondaryLoss_AspectRatio(t *testing.T) {
+	var g = nozzleGeometry()
+	var short = SecondaryLoss(g)
+	g.AspectRatio = 4
+	assert.True(t, SecondaryLoss(g) < short)
+}
+
+func TestTipClearanceLoss(t *testing.T) {
+	var g = nozzleGeometry()
+	g.TipGapRel = 0.01
+	var small = TipClearanceLoss(g)
+	assert.True(t, small > 0)
+
+	g.TipGapRel = 0.02
+	assert.True(t, TipClearanceLoss(g) > small)
+}
+
+func TestReynoldsCorrection(t *testing.T) {
+	assert.Equal(t, 1., ReynoldsCorrection(5e5))
+	assert.True(t, ReynoldsCorrection(1e5) > 1)
+	assert.True(t, ReynoldsCorrection(1e7) < 1)
+}
+
+func TestRow_Validate(t *testing.T) {
+	var g = nozzleGeometry()
+	g.OutletAngle = math.Pi / 2
+	var _, err = Row(g)
+	assert.Error(t, err)
+
+	g = nozzleGeometry()
+	g.Reynolds = 0
+	_, err = Row(g)
+	assert.Error(t, err)
+}
+
+func TestSutherlandViscosity(t *testing.T) {
+	assert.InDelta(t, 1.716e-5, SutherlandViscosity(273.15), 1e-10)
+	assert.True(t, SutherlandViscosity(1200) > SutherlandViscosity(300))
+}
+
+// значения рассчитаны вручную по формулам Эйнли-Матисона и Кекера-Окапуу для сопловой решетки:
+// (s/b)_opt = 0.614 + 70/130 = 1.1525, Y_p,AM = 0.01106 + 0.11562 * 0.30246^3.333 = 0.013209,
+// alpha_m = 53.948 град, C_L / (s/b) = 3.2339, Z = 6.0016,
+// o / b = 0.85 cos 70 = 0.29072, Delta phi^2 = 0.25 * 0.06880^2 + 0.095 * 0.06880 = 0.0077188
+func TestRow_HandComputed(t *testing.T) {
+	var g = nozzleGeometry()
+	assert.InDelta(t, 0.914*2/3*0.013209, ProfileLoss(g), 1e-6)
+	assert.InDelta(t, 1.2*0.0334*0.5*math.Cos(g.OutletAngle)*6.0016, SecondaryLoss(g), 1e-5)
+	assert.InDelta(t, 0.0077188/(1-0.0077188), TrailingEdgeLoss(g), 1e-6)
+
+	var loss, err = Row(g)
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Sqrt(1-0.0080487-0.041135-0.0077788), loss.VelocityCoef(), 1e-5)
+
+	// активная решетка 60 град при s/b = 0.7: (s/b)_opt = 0.82956, Y_p,AM = 0.071367
+	g.OutletAngle = 60 * math.Pi / 180
+	g.InletAngle = g.OutletAngle
+	g.PitchRel = 0.7
+	assert.InDelta(t, 0.914*2/3*0.071367, ProfileLoss(g), 1e-6)
+}
@@ -0,0 +1,18 @@
+package losses
+
+import "math"
+
+// SutherlandViscosity возвращает динамическую вязкость воздуха (продуктов сгорания) по формуле Сазерленда, Па с
+func SutherlandViscosity(t float64) float64 {
+	const (
+		mu0 = 1.716e-5
+		t0  = 273.15
+		s   = 110.4
+	)
+	return mu0 * math.Pow(t/t0, 1.5) * (t0 + s) / (t + s)
+}
+
+// Reynolds возвращает число Рейнольдса по хорде и скорости на выходе из решетки
+func Reynolds(density, velocity, chord, t float64) float64 {
+	return density * velocity * chord / SutherlandViscosity(t)
+}
@@ -60,41 +60,73 @@ type CompressorConfig struct {
 	IterLimit  int
 }
 
+// GetFittedStagedCompressor подбирает масштабы распределений коэффициента напора и КПД так,
+// чтобы ступенчатый компрессор соответствовал узлу цикла, и возвращает результаты согласования
 func (conf *CompressorConfig) GetFittedStagedCompressor(
 	cycleNode constructive.CompressorNode,
 	solverGen math.SolverGenerator,
-) (compressor.StagedCompressorNode, error) {
+) (compressor.StagedCompressorNode, CompressorFitDiagnostics, error) {
 	if err := cycleNode.Process(); err != nil {
-		return nil, fmt.Errorf("failed to process cycleNode %s", err.Error())
+		return nil, CompressorFitDiagnostics{}, fmt.Errorf("failed to process cycleNode %s", err.Error())
 	}
 	staged, err := conf.GetStagedCompressor()
 	if err != nil {
-		return nil, err
+		return nil, CompressorFitDiagnostics{}, err
 	}
 	staged.MassRateInput().SetState(states.NewMassRatePortState(conf.MassRate))
 
-	eqSys := compressor.GetCycleFitEqSys(
-		staged, cycleNode,
-		common.Scaler(conf.getHtNormFunc()),
-		common.Scaler(conf.getEtaFunc()),
-		conf.HtLimit, conf.EtaLimit,
-	)
+	eqSys := &countingEqSys{
+		EquationSystem: compressor.GetCycleFitEqSys(
+			staged, cycleNode,
+			common.Scaler(conf.getHtNormFunc()),
+			common.Scaler(conf.getEtaFunc()),
+			conf.HtLimit, conf.EtaLimit,
+		),
+	}
 
 	solver, err := solverGen(eqSys)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create solver %s", err.Error())
+		return nil, CompressorFitDiagnostics{}, fmt.Errorf("failed to create solver %s", err.Error())
 	}
 
-	_, err = solver.Solve(
+	solution, err := solver.Solve(
 		mat.NewVecDense(2, []float64{1, 1}),
 		conf.Precision,
 		1, 1000,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fit to cycle: %s", err.Error())
+		return nil, CompressorFitDiagnostics{}, fmt.Errorf(
+			"failed to fit to cycle after %d evaluations: %s", eqSys.evaluations, err.Error(),
+		)
+	}
+
+	return staged, newCompressorFitDiagnostics(conf, staged, cycleNode, solution, eqSys.evaluations), nil
+}
+
+// GetPredictedStagedCompressor строит компрессор с распределением КПД из конфигурации без повторного
+// согласования с циклом: распределение коэффициента напора масштабируется так же, как у компрессора fitted,
+// подобранного по циклу, входные параметры берутся у него. Расхождение с циклом отражается в результатах согласования.
+func (conf *CompressorConfig) GetPredictedStagedCompressor(
+	cycleNode constructive.CompressorNode,
+	fitted compressor.StagedCompressorNode,
+	fittedDiag CompressorFitDiagnostics,
+) (compressor.StagedCompressorNode, CompressorFitDiagnostics, error) {
+	var predictedConf = *conf
+	predictedConf.HtMax *= fittedDiag.HtFactor
+	staged, err := predictedConf.GetStagedCompressor()
+	if err != nil {
+		return nil, CompressorFitDiagnostics{}, err
+	}
+	staged.GasInput().SetState(fitted.GasInput().GetState())
+	staged.TemperatureInput().SetState(fitted.TemperatureInput().GetState())
+	staged.PressureInput().SetState(fitted.PressureInput().GetState())
+	staged.MassRateInput().SetState(fitted.MassRateInput().GetState())
+	if err := staged.Process(); err != nil {
+		return nil, CompressorFitDiagnostics{}, fmt.Errorf("failed to process predicted compressor: %s", err.Error())
 	}
 
-	return staged, nil
+	var solution = mat.NewVecDense(2, []float64{fittedDiag.HtFactor, 1})
+	return staged, newCompressorFitDiagnostics(&predictedConf, staged, cycleNode, solution, 0), nil
 }
 
 func (conf *CompressorConfig) GetStagedCompressor() (compressor.StagedCompressorNode, error) {
@@ -0,0 +1,116 @@
+package midall
+
+import (
+	"github.com/Sovianum/turbocycle/core/math"
+	"github.com/Sovianum/turbocycle/impl/engine/nodes"
+	"github.com/Sovianum/turbocycle/impl/engine/nodes/constructive"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+	"github.com/Sovianum/turbocycle/material/gases"
+	"gonum.org/v1/gonum/mat"
+	math2 "math"
+)
+
+// относительная близость к пределу, при которой считается, что оптимизатор уперся в ограничение
+const limitTolerance = 1e-3
+
+type StageLoading struct {
+	HtCoef float64
+	Eta    float64
+	Pi     float64
+
+	HtLimited  bool
+	EtaLimited bool
+}
+
+// CompressorFitDiagnostics - результаты согласования ступенчатого компрессора с циклом
+type CompressorFitDiagnostics struct {
+	HtFactor            float64 // масштаб распределения коэффициента напора
+	EtaFactor           float64 // масштаб распределения КПД
+	ResidualEvaluations int     // число вычислений невязок системы уравнений
+
+	CyclePi   float64
+	StagedPi  float64
+	CycleEta  float64
+	StagedEta float64
+
+	HtLimit  float64
+	EtaLimit float64
+	Stages   []StageLoading
+}
+
+// PiMismatch возвращает относительное расхождение степени повышения давления
+func (d CompressorFitDiagnostics) PiMismatch() float64 {
+	return (d.StagedPi - d.CyclePi) / d.CyclePi
+}
+
+func (d CompressorFitDiagnostics) EtaMismatch() float64 {
+	return d.StagedEta - d.CycleEta
+}
+
+func (d CompressorFitDiagnostics) HtLimitHit() bool {
+	for _, s := range d.Stages {
+		if s.HtLimited {
+			return true
+		}
+	}
+	return false
+}
+
+func (d CompressorFitDiagnostics) EtaLimitHit() bool {
+	for _, s := range d.Stages {
+		if s.EtaLimited {
+			return true
+		}
+	}
+	return false
+}
+
+func newCompressorFitDiagnostics(
+	conf *CompressorConfig,
+	staged compressor.StagedCompressorNode,
+	cycleNode constructive.CompressorNode,
+	solution *mat.VecDense,
+	evaluations int,
+) CompressorFitDiagnostics {
+	var result = CompressorFitDiagnostics{
+		HtFactor:            solution.AtVec(0),
+		EtaFactor:           solution.AtVec(1),
+		ResidualEvaluations: evaluations,
+		CyclePi:             cycleNode.PiStag(),
+		StagedPi:            1,
+		CycleEta:            cycleNode.Eta(),
+		HtLimit:             conf.HtLimit,
+		EtaLimit:            conf.EtaLimit,
+	}
+
+	var stages = staged.Stages()
+	result.Stages = make([]StageLoading, len(stages))
+	for i, stage := range stages {
+		var pack = stage.GetDataPack()
+		result.Stages[i] = StageLoading{
+			HtCoef:     stage.HtCoef(),
+			Eta:        pack.EtaAd,
+			Pi:         pack.PiStag,
+			HtLimited:  stage.HtCoef() >= conf.HtLimit*(1-limitTolerance),
+			EtaLimited: pack.EtaAd >= conf.EtaLimit*(1-limitTolerance),
+		}
+		result.StagedPi *= pack.PiStag
+	}
+
+	var t1 = stages[0].GetDataPack().T1Stag
+	var t3 = stages[len(stages)-1].GetDataPack().T3Stag
+	var k = gases.KMean(stages[0].Gas(), t1, t3, nodes.DefaultN)
+	result.StagedEta = t1 * (math2.Pow(result.StagedPi, (k-1)/k) - 1) / (t3 - t1)
+	return result
+}
+
+// countingEqSys подсчитывает число вычислений невязок при решении системы уравнений
+type countingEqSys struct {
+	math.EquationSystem
+	evaluations int
+}
+
+func (s *countingEqSys) GetResiduals(x *mat.VecDense) (*mat.VecDense, error) {
+	s.evaluations++
+	return s.EquationSystem.GetResiduals(x)
+}
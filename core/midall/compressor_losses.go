@@ -0,0 +1,114 @@
+package midall
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/losses"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+	"math"
+)
+
+// CompressorLossModelConfig - параметры решеток, не определяемые среднерадиусным расчетом
+type CompressorLossModelConfig struct {
+	RotorSolidity  float64 // b / t
+	StatorSolidity float64
+	RotorTipGapRel float64 // радиальный зазор / h
+	Limits         losses.DiffusionLimits
+}
+
+type CompressorStageLossPrediction struct {
+	RotorGeometry  losses.CompressorRowGeometry
+	StatorGeometry losses.CompressorRowGeometry
+	RotorLoss      losses.CompressorRowLoss
+	StatorLoss     losses.CompressorRowLoss
+	RotorCheck     losses.DiffusionCheck
+	StatorCheck    losses.DiffusionCheck
+
+	EtaFitted    float64
+	EtaPredicted float64
+}
+
+func (p CompressorStageLossPrediction) Passed() bool {
+	return p.RotorCheck.Passed() && p.StatorCheck.Passed()
+}
+
+func PredictCompressorLosses(
+	node compressor.StagedCompressorNode, conf CompressorLossModelConfig,
+) ([]CompressorStageLossPrediction, error) {
+	var stages = node.Stages()
+	var result = make([]CompressorStageLossPrediction, len(stages))
+	for i, stage := range stages {
+		var prediction, err = PredictCompressorStageLosses(stage, conf)
+		if err != nil {
+			return nil, fmt.Errorf("stage %d: %v", i+1, err)
+		}
+		result[i] = prediction
+	}
+	return result, nil
+}
+
+// PredictCompressorStageLosses оценивает потери и диффузорность венцов ступени по треугольникам скоростей
+// на среднем радиусе. Углы треугольников отсчитываются от фронта решетки.
+func PredictCompressorStageLosses(
+	stage compressor.StageNode, conf CompressorLossModelConfig,
+) (CompressorStageLossPrediction, error) {
+	var pack = stage.GetDataPack()
+	var in, mid, out = pack.InletTriangle, pack.MidTriangle, pack.OutletTriangle
+
+	var result = CompressorStageLossPrediction{
+		RotorGeometry: losses.CompressorRowGeometry{
+			InletAngle:     math.Pi/2 - in.Beta(),
+			OutletAngle:    math.Pi/2 - mid.Beta(),
+			InletVelocity:  in.W(),
+			OutletVelocity: mid.W(),
+			Solidity:       conf.RotorSolidity,
+			AspectRatio:    stage.GeomGen().RotorGenerator().Elongation(),
+			TipGapRel:      conf.RotorTipGapRel,
+		},
+		StatorGeometry: losses.CompressorRowGeometry{
+			InletAngle:     math.Pi/2 - mid.Alpha(),
+			OutletAngle:    math.Pi/2 - out.Alpha(),
+			InletVelocity:  mid.C(),
+			OutletVelocity: out.C(),
+			Solidity:       conf.StatorSolidity,
+			AspectRatio:    stage.GeomGen().StatorGenerator().Elongation(),
+		},
+		EtaFitted: pack.EtaAd,
+	}
+
+	var err error
+	if result.RotorLoss, err = losses.CompressorRow(result.RotorGeometry); err != nil {
+		return CompressorStageLossPrediction{}, fmt.Errorf("rotor: %v", err)
+	}
+	if result.StatorLoss, err = losses.CompressorRow(result.StatorGeometry); err != nil {
+		return CompressorStageLossPrediction{}, fmt.Errorf("stator: %v", err)
+	}
+	result.RotorCheck = losses.CheckDiffusion(result.RotorGeometry, conf.Limits)
+	result.StatorCheck = losses.CheckDiffusion(result.StatorGeometry, conf.Limits)
+	result.EtaPredicted = losses.StageEfficiency(
+		result.RotorLoss, result.StatorLoss,
+		result.RotorGeometry, result.StatorGeometry,
+		pack.Labour,
+	)
+	return result, nil
+}
+
+// WithPredictedEfficiency возвращает копию конфигурации, в которой бипараболическое распределение КПД
+// проведено через предсказанные значения на первой, последней и наилучшей ступенях.
+// Компрессор по такой конфигурации строится GetPredictedStagedCompressor, сохраняющим уровень КПД;
+// предел EtaLimit не опускается ниже наибольшего предсказанного КПД.
+func (conf *CompressorConfig) WithPredictedEfficiency(predictions []CompressorStageLossPrediction) (CompressorConfig, error) {
+	if len(predictions) != conf.StageNum {
+		return CompressorConfig{}, fmt.Errorf(
+			"expected %d stage predictions, got %d", conf.StageNum, len(predictions),
+		)
+	}
+	var etaArr = make([]float64, len(predictions))
+	for i, p := range predictions {
+		etaArr[i] = p.EtaPredicted
+	}
+
+	var result = *conf
+	result.EtaMax, result.EtaMaxCoord, result.EtaLossStart, result.EtaLossEnd = biParabolicParams(etaArr)
+	result.EtaLimit = math.Max(result.EtaLimit, result.EtaMax)
+	return result, nil
+}
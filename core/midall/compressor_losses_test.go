@@ -0,0 +1,35 @@
+package midall
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/losses"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWithPredictedEfficiency(t *testing.T) {
+	var conf = CompressorConfig{StageNum: 3, EtaMax: 0.88, EtaLimit: 0.89}
+	var result, err = conf.WithPredictedEfficiency([]CompressorStageLossPrediction{
+		{EtaPredicted: 0.86},
+		{EtaPredicted: 0.9},
+		{EtaPredicted: 0.87},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0.9, result.EtaMax)
+	assert.Equal(t, 1., result.EtaMaxCoord)
+	assert.InDelta(t, 1-0.86/0.9, result.EtaLossStart, 1e-12)
+	assert.InDelta(t, 1-0.87/0.9, result.EtaLossEnd, 1e-12)
+	assert.Equal(t, 0.9, result.EtaLimit)
+	assert.Equal(t, 0.88, conf.EtaMax)
+
+	_, err = conf.WithPredictedEfficiency(nil)
+	assert.Error(t, err)
+}
+
+func TestCompressorStageLossPrediction_Passed(t *testing.T) {
+	var passed = losses.DiffusionCheck{DiffusionPassed: true, DeHallerPassed: true}
+	var p = CompressorStageLossPrediction{RotorCheck: passed, StatorCheck: passed}
+	assert.True(t, p.Passed())
+
+	p.StatorCheck.DeHallerPassed = false
+	assert.False(t, p.Passed())
+}
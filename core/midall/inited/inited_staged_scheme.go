@@ -23,13 +23,54 @@ const (
 )
 
 func GetInitedStagedNodes() (*midall.StagedScheme3n, error) {
+	source, configs, err := getInitedConfigs()
+	if err != nil {
+		return nil, err
+	}
+	return midall.NewStagedScheme3n(
+		source, configs.lpc, configs.hpc, configs.hpt, configs.lpt, configs.ft,
+	)
+}
+
+// GetLossModelStagedNodes возвращает схему, подобранную по циклу, и схему, в компрессорах и турбинах которой
+// распределения КПД и коэффициентов скорости по ступеням получены из моделей потерь
+func GetLossModelStagedNodes(
+	lossConfig midall.LossModelConfig,
+	compressorLossConfig midall.CompressorLossModelConfig,
+) (fitted, predicted *midall.StagedScheme3n, err error) {
+	source, configs, err := getInitedConfigs()
+	if err != nil {
+		return nil, nil, err
+	}
+	fitted, err = midall.NewStagedScheme3n(
+		source, configs.lpc, configs.hpc, configs.hpt, configs.lpt, configs.ft,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	predicted, err = midall.NewLossModelStagedScheme3n(
+		source, fitted, configs.lpc, configs.hpc, configs.hpt, configs.lpt, configs.ft,
+		lossConfig, compressorLossConfig,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return fitted, predicted, nil
+}
+
+type stagedConfigs struct {
+	lpc, hpc     midall.CompressorConfig
+	hpt, lpt, ft midall.TurbineConfig
+}
+
+func getInitedConfigs() (schemes.ThreeShaftsScheme, stagedConfigs, error) {
 	source := s3n.GetDiplomaInitedThreeShaftsScheme()
 	network, err := source.GetNetwork()
 	if err != nil {
-		return nil, err
+		return nil, stagedConfigs{}, err
 	}
 	if err := network.Solve(relaxCoef, 2, 100, precision); err != nil {
-		return nil, err
+		return nil, stagedConfigs{}, err
 	}
 	fmt.Printf(
 		"pi_LPC = %.3f, pi_HPC = %.3f, pi_HPT = %.3f, pi_LPT = %.3f, pi_FT = %.3f\n",
@@ -60,7 +101,10 @@ func GetInitedStagedNodes() (*midall.StagedScheme3n, error) {
 	ftConfig := getFTConfig()
 	ftConfig.MassRate = ftMassRate
 
-	return midall.NewStagedScheme3n(source, lpcConfig, hpcConfig, hptConfig, lptConfig, ftConfig)
+	return source, stagedConfigs{
+		lpc: lpcConfig, hpc: hpcConfig,
+		hpt: hptConfig, lpt: lptConfig, ft: ftConfig,
+	}, nil
 }
 
 func getLPCConfig() midall.CompressorConfig {
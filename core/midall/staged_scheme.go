@@ -18,12 +18,12 @@ func NewStagedScheme3n(
 	solverGen := newton.NewUniformNewtonSolverGen(1e-5, newton.NoLog)
 	result := &StagedScheme3n{}
 
-	result.LPC, err = lpcConfig.GetFittedStagedCompressor(source.LPC(), solverGen)
+	result.LPC, result.LPCFit, err = lpcConfig.GetFittedStagedCompressor(source.LPC(), solverGen)
 	if err != nil {
 		msg += fmt.Sprintf("lpcErr: %s;\n", err.Error())
 		err = nil
 	}
-	result.HPC, err = hpcConfig.GetFittedStagedCompressor(source.HPC(), solverGen)
+	result.HPC, result.HPCFit, err = hpcConfig.GetFittedStagedCompressor(source.HPC(), solverGen)
 	if err != nil {
 		msg += fmt.Sprintf("hpcErr: %s;\n", err.Error())
 		err = nil
@@ -53,7 +53,77 @@ type StagedScheme3n struct {
 	LPC compressor.StagedCompressorNode
 	HPC compressor.StagedCompressorNode
 
+	LPCFit CompressorFitDiagnostics
+	HPCFit CompressorFitDiagnostics
+
 	HPT turbine.StagedTurbineNode
 	LPT turbine.StagedTurbineNode
 	FT  turbine.StagedTurbineNode
 }
+
+// NewLossModelStagedScheme3n строит компрессоры и турбины, в которых распределения КПД и коэффициентов скорости
+// по ступеням получены из моделей потерь, рассчитанных по ступеням схемы fitted, подобранной по циклу.
+// Машины не согласуются с циклом повторно, чтобы сохранить предсказанный уровень КПД и коэффициентов скорости.
+func NewLossModelStagedScheme3n(
+	source schemes.ThreeShaftsScheme,
+	fitted *StagedScheme3n,
+	lpcConfig, hpcConfig CompressorConfig,
+	hptConfig, lptConfig, ftConfig TurbineConfig,
+	lossConfig LossModelConfig,
+	compressorLossConfig CompressorLossModelConfig,
+) (*StagedScheme3n, error) {
+	var err error
+	var result = &StagedScheme3n{}
+
+	var predictedCompressorConfig = func(
+		name string, node compressor.StagedCompressorNode, conf CompressorConfig,
+	) (CompressorConfig, error) {
+		predictions, err := PredictCompressorLosses(node, compressorLossConfig)
+		if err != nil {
+			return CompressorConfig{}, fmt.Errorf("%s: %v", name, err)
+		}
+		return conf.WithPredictedEfficiency(predictions)
+	}
+	if lpcConfig, err = predictedCompressorConfig("lpc", fitted.LPC, lpcConfig); err != nil {
+		return nil, err
+	}
+	result.LPC, result.LPCFit, err = lpcConfig.GetPredictedStagedCompressor(source.LPC(), fitted.LPC, fitted.LPCFit)
+	if err != nil {
+		return nil, fmt.Errorf("lpc: %v", err)
+	}
+	if hpcConfig, err = predictedCompressorConfig("hpc", fitted.HPC, hpcConfig); err != nil {
+		return nil, err
+	}
+	result.HPC, result.HPCFit, err = hpcConfig.GetPredictedStagedCompressor(source.HPC(), fitted.HPC, fitted.HPCFit)
+	if err != nil {
+		return nil, fmt.Errorf("hpc: %v", err)
+	}
+
+	var predictedTurbine = func(
+		name string, node turbine.StagedTurbineNode, conf TurbineConfig,
+	) (turbine.StagedTurbineNode, error) {
+		predictions, err := PredictTurbineLosses(node, lossConfig)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		predictedConf, err := conf.WithPredictedLosses(predictions)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		staged, err := predictedConf.GetPredictedStagedTurbine(node)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		return staged, nil
+	}
+	if result.HPT, err = predictedTurbine("hpt", fitted.HPT, hptConfig); err != nil {
+		return nil, err
+	}
+	if result.LPT, err = predictedTurbine("lpt", fitted.LPT, lptConfig); err != nil {
+		return nil, err
+	}
+	if result.FT, err = predictedTurbine("ft", fitted.FT, ftConfig); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
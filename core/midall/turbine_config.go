@@ -99,6 +99,26 @@ func (conf *TurbineConfig) GetFittedStagedTurbine(
 	return staged, nil
 }
 
+// GetPredictedStagedTurbine строит турбину с распределениями phi и psi из конфигурации без согласования с циклом:
+// полный теплоперепад и входные параметры берутся у турбины fitted, подобранной по циклу,
+// поэтому отличие КПД от цикла определяется только заданными коэффициентами скорости
+func (conf *TurbineConfig) GetPredictedStagedTurbine(fitted turbine.StagedTurbineNode) (turbine.StagedTurbineNode, error) {
+	var predictedConf = *conf
+	predictedConf.TotalHeatDrop = fitted.Ht()
+	staged, err := predictedConf.GetStagedTurbine()
+	if err != nil {
+		return nil, err
+	}
+	staged.GasInput().SetState(fitted.GasInput().GetState())
+	staged.TemperatureInput().SetState(fitted.TemperatureInput().GetState())
+	staged.PressureInput().SetState(fitted.PressureInput().GetState())
+	staged.MassRateInput().SetState(fitted.MassRateInput().GetState())
+	if err := staged.Process(); err != nil {
+		return nil, fmt.Errorf("failed to process predicted turbine: %s", err.Error())
+	}
+	return staged, nil
+}
+
 func (conf *TurbineConfig) GetStagedTurbine() (turbine.StagedTurbineNode, error) {
 	if err := conf.validate(); err != nil {
 		return nil, err
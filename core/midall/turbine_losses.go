@@ -0,0 +1,151 @@
+package midall
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/losses"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"math"
+)
+
+// LossModelConfig - параметры профилей, не определяемые среднерадиусным расчетом
+type LossModelConfig struct {
+	StatorThicknessRel   float64 // c_max / b
+	RotorThicknessRel    float64
+	StatorTEThicknessRel float64 // толщина выходной кромки / b
+	RotorTEThicknessRel  float64
+}
+
+type StageLossPrediction struct {
+	StatorGeometry losses.RowGeometry
+	RotorGeometry  losses.RowGeometry
+	StatorLoss     losses.RowLoss
+	RotorLoss      losses.RowLoss
+
+	PhiFitted     float64
+	PhiPredicted  float64
+	PsiFitted     float64
+	PsiPredicted  float64
+	EtaUFitted    float64
+	EtaUPredicted float64
+	EtaFitted     float64 // КПД ступени по параметрам торможения
+	EtaPredicted  float64
+}
+
+// PredictTurbineLosses оценивает коэффициенты скорости всех ступеней турбины по геометрии.
+// Первая ступень считается ступенью с осевым входом.
+func PredictTurbineLosses(node turbine.StagedTurbineNode, conf LossModelConfig) ([]StageLossPrediction, error) {
+	var stages = node.Stages()
+	var result = make([]StageLossPrediction, len(stages))
+	var inletAlpha = math.Pi / 2
+	for i, stage := range stages {
+		var prediction, err = PredictStageLosses(stage, inletAlpha, conf)
+		if err != nil {
+			return nil, fmt.Errorf("stage %d: %v", i+1, err)
+		}
+		result[i] = prediction
+		inletAlpha = stage.GetDataPack().RotorOutletTriangle.Alpha()
+	}
+	return result, nil
+}
+
+// PredictStageLosses оценивает потери в ступени по модели Кекера-Окапуу и пересчитывает
+// подобранные по циклу потери в сопловом аппарате и рабочем колесе на предсказанные коэффициенты скорости.
+// inletAlpha - угол потока на входе в сопловой аппарат, отсчитанный от фронта решетки.
+func PredictStageLosses(stage turbine.StageNode, inletAlpha float64, conf LossModelConfig) (StageLossPrediction, error) {
+	var pack = stage.GetDataPack()
+	if pack.Err != nil {
+		return StageLossPrediction{}, pack.Err
+	}
+	var statorGen = stage.StageGeomGen().StatorGenerator()
+	var rotorGen = stage.StageGeomGen().RotorGenerator()
+	var statorGeom = pack.StageGeometry.StatorGeometry()
+	var rotorGeom = pack.StageGeometry.RotorGeometry()
+
+	var statorChord = geometry.Height(statorGeom.XBladeOut(), statorGeom) / statorGen.Elongation()
+	var rotorChord = geometry.Height(rotorGeom.XBladeOut(), rotorGeom) / rotorGen.Elongation()
+
+	var result = StageLossPrediction{
+		StatorGeometry: losses.RowGeometry{
+			InletAngle:     math.Pi/2 - inletAlpha,
+			OutletAngle:    math.Pi/2 - pack.Alpha1,
+			PitchRel:       turbine.TRel(0.5, statorGen),
+			AspectRatio:    statorGen.Elongation(),
+			ThicknessRel:   conf.StatorThicknessRel,
+			TEThicknessRel: conf.StatorTEThicknessRel,
+			Reynolds:       losses.Reynolds(pack.Density1, pack.C1, statorChord, pack.T1),
+		},
+		RotorGeometry: losses.RowGeometry{
+			InletAngle:     math.Pi/2 - pack.RotorInletTriangle.Beta(),
+			OutletAngle:    math.Pi/2 - pack.RotorOutletTriangle.Beta(),
+			PitchRel:       turbine.TRel(0.5, rotorGen),
+			AspectRatio:    rotorGen.Elongation(),
+			ThicknessRel:   conf.RotorThicknessRel,
+			TEThicknessRel: conf.RotorTEThicknessRel,
+			TipGapRel:      pack.AirGapRel,
+			Reynolds: losses.Reynolds(
+				pack.Density2, pack.RotorOutletTriangle.W(), rotorChord, pack.T2,
+			),
+		},
+		PhiFitted:  pack.Phi,
+		PsiFitted:  pack.Psi,
+		EtaUFitted: pack.EtaU,
+		EtaFitted:  pack.EtaTStag,
+	}
+
+	var err error
+	if result.StatorLoss, err = losses.Row(result.StatorGeometry); err != nil {
+		return StageLossPrediction{}, fmt.Errorf("stator: %v", err)
+	}
+	if result.RotorLoss, err = losses.Row(result.RotorGeometry); err != nil {
+		return StageLossPrediction{}, fmt.Errorf("rotor: %v", err)
+	}
+	result.PhiPredicted = result.StatorLoss.VelocityCoef()
+	result.PsiPredicted = result.RotorLoss.VelocityCoef()
+
+	// потери h = c_ад^2 / 2 (1 - phi^2), изменение потерь относится к располагаемому теплоперепаду
+	var statorLoss = func(phi float64) float64 { return pack.C1Ad * pack.C1Ad / 2 * (1 - phi*phi) }
+	var rotorLoss = func(psi float64) float64 { return pack.WAd2 * pack.WAd2 / 2 * (1 - psi*psi) }
+	var dLoss = statorLoss(result.PhiPredicted) - statorLoss(result.PhiFitted) +
+		rotorLoss(result.PsiPredicted) - rotorLoss(result.PsiFitted)
+
+	result.EtaUPredicted = pack.EtaU * (1 - dLoss/pack.MeanRadiusLabour)
+	result.EtaPredicted = pack.EtaTStag - dLoss/pack.StageHeatDropStag
+	return result, nil
+}
+
+// WithPredictedLosses возвращает копию конфигурации, в которой бипараболические распределения
+// phi и psi проведены через предсказанные значения на первой, последней и наилучшей ступенях.
+// Турбина по такой конфигурации строится GetPredictedStagedTurbine, сохраняющим уровень распределений.
+func (conf *TurbineConfig) WithPredictedLosses(predictions []StageLossPrediction) (TurbineConfig, error) {
+	if len(predictions) != conf.StageNum {
+		return TurbineConfig{}, fmt.Errorf(
+			"expected %d stage predictions, got %d", conf.StageNum, len(predictions),
+		)
+	}
+	var phiArr = make([]float64, len(predictions))
+	var psiArr = make([]float64, len(predictions))
+	for i, p := range predictions {
+		phiArr[i] = p.PhiPredicted
+		psiArr[i] = p.PsiPredicted
+	}
+
+	var result = *conf
+	result.PhiMax, result.PhiMaxCoord, result.PhiStartLoss, result.PhiEndLoss = biParabolicParams(phiArr)
+	result.PsiMax, result.PsiMaxCoord, result.PsiStartLoss, result.PsiEndLoss = biParabolicParams(psiArr)
+	return result, nil
+}
+
+func biParabolicParams(values []float64) (max, maxCoord, startLoss, endLoss float64) {
+	var iMax = 0
+	for i, v := range values {
+		if v > values[iMax] {
+			iMax = i
+		}
+	}
+	max = values[iMax]
+	maxCoord = float64(iMax)
+	startLoss = 1 - values[0]/max
+	endLoss = 1 - values[len(values)-1]/max
+	return
+}
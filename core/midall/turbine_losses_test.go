@@ -0,0 +1,107 @@
+package midall
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/losses"
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/engine/states"
+	states2 "github.com/Sovianum/turbocycle/impl/stage/states"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/material/gases"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+var testLossConfig = LossModelConfig{
+	StatorThicknessRel:   0.2,
+	RotorThicknessRel:    0.25,
+	StatorTEThicknessRel: 0.02,
+	RotorTEThicknessRel:  0.025,
+}
+
+func getTestTurbineStage() turbine.StageNode {
+	var gen = turbine.NewStageGeometryGenerator(
+		0.15,
+		turbine.NewIncompleteGenerator(4, 0.1, -0.09, 0.09, 0.7),
+		turbine.NewIncompleteGenerator(4, 0.1, -0.09, 0.09, 0.7),
+	)
+	var stage = turbine.NewTurbineSingleStageNode(1e4, 3e5, 0.5, 0.96, 0.95, 0.001, 0.05, gen)
+	stage.GasInput().SetState(states.NewGasPortState(gases.GetAir()))
+	stage.VelocityInput().SetState(states2.NewVelocityPortState(
+		states2.NewInletTriangle(0, 50, math.Pi/2),
+		states2.InletTriangleType,
+	))
+	stage.TemperatureInput().SetState(states.NewTemperaturePortState(1200))
+	stage.PressureInput().SetState(states.NewPressurePortState(1e6))
+	stage.MassRateInput().SetState(states.NewMassRatePortState(100))
+	stage.SetAlpha1FirstStage(common.ToRadians(14))
+	if err := stage.Process(); err != nil {
+		panic(err)
+	}
+	return stage
+}
+
+func TestPredictStageLosses(t *testing.T) {
+	var stage = getTestTurbineStage()
+	var pack = stage.GetDataPack()
+
+	var p, err = PredictStageLosses(stage, math.Pi/2, testLossConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, pack.Phi, p.PhiFitted)
+	assert.Equal(t, pack.Psi, p.PsiFitted)
+	assert.Equal(t, 0., p.StatorGeometry.InletAngle)
+	assert.InDelta(t, math.Pi/2-pack.Alpha1, p.StatorGeometry.OutletAngle, 1e-12)
+	assert.Equal(t, 0., p.StatorGeometry.TipGapRel)
+	assert.Equal(t, pack.AirGapRel, p.RotorGeometry.TipGapRel)
+
+	var statorLoss, _ = losses.Row(p.StatorGeometry)
+	var rotorLoss, _ = losses.Row(p.RotorGeometry)
+	assert.Equal(t, statorLoss, p.StatorLoss)
+	assert.Equal(t, rotorLoss, p.RotorLoss)
+	assert.InDelta(t, statorLoss.VelocityCoef(), p.PhiPredicted, 1e-12)
+	assert.InDelta(t, rotorLoss.VelocityCoef(), p.PsiPredicted, 1e-12)
+
+	// изменение КПД определяется разностью потерь c_ад^2 / 2 (1 - phi^2) и w_ад^2 / 2 (1 - psi^2)
+	var dLoss = pack.C1Ad*pack.C1Ad/2*(p.PhiFitted*p.PhiFitted-p.PhiPredicted*p.PhiPredicted) +
+		pack.WAd2*pack.WAd2/2*(p.PsiFitted*p.PsiFitted-p.PsiPredicted*p.PsiPredicted)
+	assert.InDelta(t, pack.EtaTStag-dLoss/pack.StageHeatDropStag, p.EtaPredicted, 1e-9)
+	assert.InDelta(t, pack.EtaU*(1-dLoss/pack.MeanRadiusLabour), p.EtaUPredicted, 1e-9)
+}
+
+func TestWithPredictedLosses(t *testing.T) {
+	var conf = TurbineConfig{StageNum: 3, PhiMax: 0.97, PsiMax: 0.97}
+	var result, err = conf.WithPredictedLosses([]StageLossPrediction{
+		{PhiPredicted: 0.95, PsiPredicted: 0.94},
+		{PhiPredicted: 0.97, PsiPredicted: 0.93},
+		{PhiPredicted: 0.96, PsiPredicted: 0.92},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0.97, result.PhiMax)
+	assert.Equal(t, 1., result.PhiMaxCoord)
+	assert.InDelta(t, 1-0.95/0.97, result.PhiStartLoss, 1e-12)
+	assert.InDelta(t, 1-0.96/0.97, result.PhiEndLoss, 1e-12)
+	assert.Equal(t, 0.94, result.PsiMax)
+	assert.Equal(t, 0., result.PsiMaxCoord)
+	assert.Equal(t, 0., result.PsiStartLoss)
+	assert.InDelta(t, 1-0.92/0.94, result.PsiEndLoss, 1e-12)
+
+	// исходная конфигурация не изменяется
+	assert.Equal(t, 0.97, conf.PsiMax)
+
+	_, err = conf.WithPredictedLosses([]StageLossPrediction{{}})
+	assert.Error(t, err)
+}
+
+func TestBiParabolicParams(t *testing.T) {
+	var max, maxCoord, startLoss, endLoss = biParabolicParams([]float64{0.8, 0.9, 0.85, 0.7})
+	assert.Equal(t, 0.9, max)
+	assert.Equal(t, 1., maxCoord)
+	assert.InDelta(t, 1-0.8/0.9, startLoss, 1e-12)
+	assert.InDelta(t, 1-0.7/0.9, endLoss, 1e-12)
+
+	max, maxCoord, startLoss, endLoss = biParabolicParams([]float64{0.9})
+	assert.Equal(t, 0.9, max)
+	assert.Equal(t, 0., maxCoord)
+	assert.Equal(t, 0., startLoss)
+	assert.Equal(t, 0., endLoss)
+}
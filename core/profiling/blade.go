@@ -0,0 +1,49 @@
+package profiling
+
+import (
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
+	"github.com/Sovianum/turbocycle/utils/turbine/geom"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
+)
+
+// BladeConfig задает параметры построения сечений лопатки по профайлеру
+type BladeConfig struct {
+	HRelArr  []float64
+	PointNum int
+	IsRotor  bool
+	Edges    EdgeConfig
+
+	Stacking StackingConfig
+}
+
+// BuildBlade строит сечения так же, как профили решетки (CascadeProfile): с углом установки
+// и закруткой по высоте, - масштабирует их по осевой проекции хорды и укладывает по conf.Stacking
+func BuildBlade(
+	profiler profilers.Profiler,
+	bladingGeom geometry.BladingGeometry,
+	conf BladeConfig,
+) (BladeGrid, error) {
+	var tArr = common.LinSpace(0, 1, conf.PointNum)
+	var scale = geometry.ChordProjection(bladingGeom)
+
+	var sections = make([]Section, len(conf.HRelArr))
+	for i, hRel := range conf.HRelArr {
+		var bladeProfile = CascadeProfile(profiler, hRel, conf.Edges, conf.IsRotor)
+		bladeProfile.Transform(geom.Scale(scale))
+
+		sections[i] = Section{
+			Z:      bladeRadius(bladingGeom, hRel),
+			Points: geom.GetCoordinates(tArr, profiles.CircularSegment(bladeProfile)),
+		}
+	}
+	return Stack(sections, conf.Stacking)
+}
+
+func bladeRadius(bladingGeom geometry.BladingGeometry, hRel float64) float64 {
+	var x = bladingGeom.XBladeOut() / 2
+	var rIn = bladingGeom.InnerProfile().Diameter(x) / 2
+	var rOut = bladingGeom.OuterProfile().Diameter(x) / 2
+	return rIn + (rOut-rIn)*hRel
+}
@@ -0,0 +1,95 @@
+package profiling
+
+import (
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/engine/states"
+	states2 "github.com/Sovianum/turbocycle/impl/stage/states"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/material/gases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func newTestTurbineStage(t *testing.T) turbine.StageNode {
+	var gen = turbine.NewStageGeometryGenerator(
+		0.15,
+		turbine.NewIncompleteGenerator(4, 0.1, -0.09, 0.09, 0.7),
+		turbine.NewIncompleteGenerator(4, 0.1, -0.09, 0.09, 0.7),
+	)
+	var stage = turbine.NewTurbineSingleStageNode(1e4, 3e5, 0.5, 0.98, 0.98, 0.001, 0.05, gen)
+
+	stage.GasInput().SetState(states.NewGasPortState(gases.GetAir()))
+	stage.VelocityInput().SetState(states2.NewVelocityPortState(
+		states2.NewInletTriangle(0, 50, math.Pi/2),
+		states2.InletTriangleType,
+	))
+	stage.TemperatureInput().SetState(states.NewTemperaturePortState(1200))
+	stage.PressureInput().SetState(states.NewPressurePortState(1e6))
+	stage.MassRateInput().SetState(states.NewMassRatePortState(100))
+	stage.SetAlpha1FirstStage(common.ToRadians(14))
+
+	require.NoError(t, stage.Process())
+	return stage
+}
+
+// chordAngle возвращает угол (по модулю pi) прямой, соединяющей наиболее удаленные точки сечения.
+// При малых радиусах кромок она близка к хорде профиля.
+func chordAngle(points [][]float64) float64 {
+	var maxDist = -1.
+	var result float64
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			var dx, dy = points[j][0] - points[i][0], points[j][1] - points[i][1]
+			if dist := math.Hypot(dx, dy); dist > maxDist {
+				maxDist = dist
+				result = math.Atan2(dy, dx)
+			}
+		}
+	}
+	return math.Mod(result+2*math.Pi, math.Pi)
+}
+
+// angleDiff - разность направлений прямых по модулю pi
+func angleDiff(a, b float64) float64 {
+	var d = math.Mod(math.Abs(a-b), math.Pi)
+	return math.Min(d, math.Pi-d)
+}
+
+func TestBuildBlade_InstallationAngle(t *testing.T) {
+	var stage = newTestTurbineStage(t)
+	var pack = stage.GetDataPack()
+
+	for _, isRotor := range []bool{false, true} {
+		var spec, bladingGeom = DefaultStatorSpec(), pack.StageGeometry.StatorGeometry()
+		if isRotor {
+			spec, bladingGeom = DefaultRotorSpec(), pack.StageGeometry.RotorGeometry()
+		}
+		var profiler, err = NewTurbineStageProfiler(spec, stage)
+		require.NoError(t, err)
+
+		var hRelArr = []float64{0, 0.5, 1}
+		grid, err := BuildBlade(profiler, bladingGeom, BladeConfig{
+			HRelArr:  hRelArr,
+			PointNum: 101,
+			IsRotor:  isRotor,
+			Edges:    EdgeConfig{InletEdgeRadius: 0.01, OutletEdgeRadius: 0.01, InletEdgeAngle: 0.2, OutletEdgeAngle: 0.2},
+			Stacking: StackingConfig{Mode: StackCG},
+		})
+		require.NoError(t, err)
+
+		for _, i := range []int{0, len(hRelArr) - 1} {
+			// профиль строится с хордой вдоль оси x и поворачивается на угол установки
+			// (рабочая лопатка предварительно отражается)
+			var expected = profiler.InstallationAngle(hRelArr[i])
+			if isRotor {
+				expected = -expected
+			}
+			assert.InDelta(
+				t, 0, angleDiff(expected, chordAngle(grid[i])), common.ToRadians(2),
+				"rotor: %v, hRel: %v", isRotor, hRelArr[i],
+			)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package profiling
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	NACA65 = "naca65" // средняя линия NACA a = 1.0, толщина NACA 65-010
+	C4     = "c4"     // дужка окружности, британский профиль C4
+	DCA    = "dca"    // двухдужечный профиль
+)
+
+// CompressorBladeConfig задает семейство и основные параметры профилей компрессорной решетки
+type CompressorBladeConfig struct {
+	Family       string
+	ThicknessRel float64 // c_max / b
+	Solidity     float64 // b / t
+	PointNum     int     // число точек на каждой стороне профиля
+}
+
+func (conf CompressorBladeConfig) Validate() error {
+	if _, ok := thicknessTables[conf.Family]; !ok && conf.Family != DCA {
+		return fmt.Errorf("unknown compressor profile family \"%s\"", conf.Family)
+	}
+	if conf.ThicknessRel <= 0 || conf.ThicknessRel >= 0.3 {
+		return fmt.Errorf("thickness must be in (0, 0.3), got %.3f", conf.ThicknessRel)
+	}
+	if conf.Solidity <= 0 {
+		return fmt.Errorf("solidity must be positive, got %.3f", conf.Solidity)
+	}
+	if conf.PointNum < 3 {
+		return fmt.Errorf("at least 3 points per side required, got %d", conf.PointNum)
+	}
+	return nil
+}
+
+// CompressorSection - сечение компрессорной решетки при хорде b = 1.
+// Углы отсчитываются от осевого направления, ось x направлена по потоку, шаг - вдоль оси y.
+type CompressorSection struct {
+	HRel float64
+
+	InletFlowAngle   float64
+	OutletFlowAngle  float64
+	Incidence        float64
+	Deviation        float64
+	InletBladeAngle  float64
+	OutletBladeAngle float64
+	Camber           float64
+	Stagger          float64
+	Pitch            float64
+
+	PS [][]float64 // от входной кромки к выходной
+	SS [][]float64
+}
+
+func NewCompressorSection(conf CompressorBladeConfig, hRel, inletFlowAngle, outletFlowAngle float64) (CompressorSection, error) {
+	if err := conf.Validate(); err != nil {
+		return CompressorSection{}, err
+	}
+
+	var i0, n = ReferenceIncidence(conf.Family, conf.ThicknessRel, conf.Solidity, inletFlowAngle)
+	var delta0 = ReferenceDeviation(conf.Family, conf.ThicknessRel, conf.Solidity, inletFlowAngle)
+	var m, b = DeviationCoefs(conf.Family, inletFlowAngle, outletFlowAngle)
+	var solidityFactor = math.Pow(conf.Solidity, b)
+
+	// i = i0 + n theta, delta = delta0 + m theta / sigma^b, theta = kappa1 - kappa2
+	var denominator = 1 + n - m/solidityFactor
+	if denominator <= 0 {
+		return CompressorSection{}, fmt.Errorf("incidence and deviation correlations are degenerate")
+	}
+	var camber = (inletFlowAngle - outletFlowAngle - i0 + delta0) / denominator
+
+	var s = CompressorSection{
+		HRel:            hRel,
+		InletFlowAngle:  inletFlowAngle,
+		OutletFlowAngle: outletFlowAngle,
+		Incidence:       i0 + n*camber,
+		Deviation:       delta0 + m*camber/solidityFactor,
+		Camber:          camber,
+		Pitch:           1 / conf.Solidity,
+	}
+	s.InletBladeAngle = inletFlowAngle - s.Incidence
+	s.OutletBladeAngle = outletFlowAngle - s.Deviation
+	s.Stagger = (s.InletBladeAngle + s.OutletBladeAngle) / 2
+	s.SS, s.PS = compressorProfile(conf, camber, s.Stagger)
+	return s, nil
+}
+
+// Contour возвращает замкнутый контур профиля: спинка от входной кромки к выходной, затем корыто обратно
+func (s CompressorSection) Contour() [][]float64 {
+	var result = make([][]float64, 0, len(s.SS)+len(s.PS)-2)
+	result = append(result, s.SS...)
+	for i := len(s.PS) - 2; i > 0; i-- {
+		result = append(result, s.PS[i])
+	}
+	return result
+}
+
+// Cascade переводит сечение к системе CascadeSection (углы от фронта решетки) для проверки качества
+func (s CompressorSection) Cascade() CascadeSection {
+	return CascadeSection{
+		HRel:  s.HRel,
+		PS:    s.PS,
+		SS:    s.SS,
+		Pitch: []float64{0, s.Pitch},
+
+		InletFlowAngle:  math.Pi/2 - s.InletFlowAngle,
+		InletBladeAngle: math.Pi/2 - s.InletBladeAngle,
+		OutletFlowAngle: math.Pi/2 - s.OutletFlowAngle,
+	}
+}
+
+// ReferenceIncidence возвращает угол атаки при нулевом изгибе i0 и наклон n = di / d(theta)
+// по аппроксимации Аунгиера для корреляции Либлейна. Углы в радианах от осевого направления.
+func ReferenceIncidence(family string, thicknessRel, solidity, inletFlowAngle float64) (i0, n float64) {
+	var beta = math.Abs(toDegrees(inletFlowAngle))
+	var sigma = solidity
+
+	var p = 0.914 + sigma*sigma*sigma/160
+	var i010 = math.Pow(beta, p)/(5+46*math.Exp(-2.3*sigma)) - 0.1*sigma*sigma*sigma*math.Exp((beta-70)/4)
+	var q = 0.28 / (0.1 + math.Pow(thicknessRel, 0.3))
+	var kt = math.Pow(10*thicknessRel, q)
+
+	i0 = shapeCoefs[family] * kt * i010 * math.Pi / 180
+	n = 0.025*sigma - 0.06 - math.Pow(beta/90, 1+1.2*sigma)/(1.5+0.43*sigma)
+	return
+}
+
+// ReferenceDeviation возвращает угол отставания при нулевом изгибе delta0 = (K_delta)_sh (K_delta)_t (delta0)_10
+// по аппроксимации Аунгиера для корреляции Либлейна. Правило Картера, используемое для профилей
+// с дужкой окружности, этой составляющей не имеет, поэтому для них возвращается ноль.
+func ReferenceDeviation(family string, thicknessRel, solidity, inletFlowAngle float64) float64 {
+	if family != NACA65 {
+		return 0
+	}
+	var beta = math.Abs(toDegrees(inletFlowAngle))
+	var sigma = solidity
+
+	var delta010 = 0.01*sigma*beta + (0.74*math.Pow(sigma, 1.9)+3*sigma)*math.Pow(beta/90, 1.67+1.09*sigma)
+	var kt = 6.25*thicknessRel + 37.5*thicknessRel*thicknessRel
+	return shapeCoefs[family] * kt * delta010 * math.Pi / 180
+}
+
+// DeviationCoefs возвращает коэффициенты m и b в зависимости угла отставания delta = delta0 + m theta / sigma^b.
+// Для профилей с дужкой окружности используется правило Картера, для NACA65 со средней линией
+// a = 1.0 - аппроксимация Аунгиера для корреляции Либлейна.
+func DeviationCoefs(family string, inletFlowAngle, outletFlowAngle float64) (m, b float64) {
+	if family == NACA65 {
+		var x = math.Abs(toDegrees(inletFlowAngle)) / 100
+		return 0.17 - 0.0333*x + 0.333*x*x, 0.9625 - 0.17*x - 0.85*x*x*x
+	}
+	return CarterCoef(outletFlowAngle), 0.5
+}
+
+// CarterCoef возвращает коэффициент m правила Картера delta = m theta / sqrt(sigma)
+// для профилей с дужкой окружности (максимальный прогиб на середине хорды, a / b = 0.5)
+func CarterCoef(outletFlowAngle float64) float64 {
+	return 0.23 + toDegrees(outletFlowAngle)/500
+}
+
+// CamberLine возвращает ординату и угол наклона средней линии с углом изгиба camber при хорде 1
+func CamberLine(family string, camber, x float64) (y, slope float64) {
+	if family == NACA65 {
+		// теоретический коэффициент подъемной силы, theta = 25 c_l0 (в градусах)
+		var cl0 = toDegrees(camber) / 25
+		var k = cl0 / (4 * math.Pi)
+		y = -k * (xLogX(1-x) + xLogX(x))
+		slope = math.Atan(k * math.Log((1-x)/x))
+		return
+	}
+	if camber == 0 {
+		return 0, 0
+	}
+	var sign = 1.
+	if camber < 0 {
+		sign, camber = -1, -camber
+	}
+	var r = 1 / (2 * math.Sin(camber/2))
+	y = sign * (math.Sqrt(r*r-(x-0.5)*(x-0.5)) - r*math.Cos(camber/2))
+	slope = sign * math.Asin((0.5-x)/r)
+	return
+}
+
+// HalfThickness возвращает полутолщину профиля при хорде 1
+func HalfThickness(family string, thicknessRel, x float64) float64 {
+	if family == DCA {
+		var halfThk = thicknessRel / 2
+		var r = (0.25 + halfThk*halfThk) / (2 * halfThk)
+		return math.Max(0, math.Sqrt(r*r-(x-0.5)*(x-0.5))-(r-halfThk))
+	}
+	var table = thicknessTables[family]
+	return interpTable(table[0], table[1], x) * thicknessRel / 0.1
+}
+
+func compressorProfile(conf CompressorBladeConfig, camber, stagger float64) (ss, ps [][]float64) {
+	ss = make([][]float64, conf.PointNum)
+	ps = make([][]float64, conf.PointNum)
+	var cosG, sinG = math.Cos(stagger), math.Sin(stagger)
+	var transform = func(x, y float64) []float64 {
+		return []float64{x*cosG - y*sinG, x*sinG + y*cosG}
+	}
+
+	for i := 0; i != conf.PointNum; i++ {
+		// косинусное сгущение точек к кромкам
+		var x = (1 - math.Cos(math.Pi*float64(i)/float64(conf.PointNum-1))) / 2
+		var yc, slope = CamberLine(conf.Family, camber, x)
+		var yt = HalfThickness(conf.Family, conf.ThicknessRel, x)
+
+		// изгиб средней линии направлен в сторону положительных y, выпуклая сторона - спинка
+		ss[i] = transform(x-yt*math.Sin(slope), yc+yt*math.Cos(slope))
+		ps[i] = transform(x+yt*math.Sin(slope), yc-yt*math.Cos(slope))
+	}
+	return
+}
+
+func toDegrees(angle float64) float64 {
+	return angle * 180 / math.Pi
+}
+
+func xLogX(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return x * math.Log(x)
+}
+
+func interpTable(xArr, yArr []float64, x float64) float64 {
+	if x <= xArr[0] {
+		return yArr[0]
+	}
+	if x >= xArr[len(xArr)-1] {
+		return yArr[len(yArr)-1]
+	}
+	var i = sort.SearchFloat64s(xArr, x)
+	return yArr[i-1] + (yArr[i]-yArr[i-1])*(x-xArr[i-1])/(xArr[i]-xArr[i-1])
+}
+
+// коэффициенты формы профиля (K_i)_sh в корреляции угла атаки
+var shapeCoefs = map[string]float64{
+	NACA65: 1,
+	C4:     1.1,
+	DCA:    0.7,
+}
+
+// полутолщины профилей с относительной толщиной 10 %
+var thicknessTables = map[string][2][]float64{
+	NACA65: {
+		{0, 0.005, 0.0075, 0.0125, 0.025, 0.05, 0.075, 0.1, 0.15, 0.2, 0.25, 0.3, 0.35,
+			0.4, 0.45, 0.5, 0.55, 0.6, 0.65, 0.7, 0.75, 0.8, 0.85, 0.9, 0.95, 1},
+		{0, 0.00772, 0.00932, 0.01169, 0.01574, 0.02177, 0.02674, 0.0304, 0.03666, 0.04143, 0.04503, 0.0476, 0.04924,
+			0.04996, 0.04963, 0.04812, 0.0453, 0.04146, 0.03682, 0.03156, 0.02584, 0.01987, 0.01385, 0.0081, 0.00306, 0},
+	},
+	C4: {
+		{0, 0.0125, 0.025, 0.05, 0.075, 0.1, 0.15, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 0.95, 1},
+		{0, 0.0165, 0.0227, 0.0308, 0.0362, 0.0402, 0.0455, 0.0483, 0.05, 0.0489, 0.0457, 0.0405, 0.0337, 0.0254,
+			0.016, 0.0106, 0},
+	},
+}
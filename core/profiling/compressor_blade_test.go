@@ -0,0 +1,105 @@
+package profiling
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestCamberLine(t *testing.T) {
+	var camber = 0.5
+	for _, family := range []string{C4, DCA} {
+		var y0, slope0 = CamberLine(family, camber, 0)
+		var y1, slope1 = CamberLine(family, camber, 1)
+		assert.InDelta(t, 0, y0, 1e-12)
+		assert.InDelta(t, 0, y1, 1e-12)
+		assert.InDelta(t, camber/2, slope0, 1e-12)
+		assert.InDelta(t, -camber/2, slope1, 1e-12)
+	}
+
+	// максимальный прогиб средней линии a = 1.0 равен 0.05516 c_l0
+	var cl0 = toDegrees(camber) / 25
+	var yMid, slopeMid = CamberLine(NACA65, camber, 0.5)
+	assert.InDelta(t, 0.05516*cl0, yMid, 1e-4)
+	assert.InDelta(t, 0, slopeMid, 1e-12)
+	var yLE, _ = CamberLine(NACA65, camber, 0)
+	assert.InDelta(t, 0, yLE, 1e-12)
+}
+
+func TestHalfThickness(t *testing.T) {
+	assert.InDelta(t, 0.04996, HalfThickness(NACA65, 0.1, 0.4), 1e-9)
+	assert.InDelta(t, 0.05, HalfThickness(C4, 0.2, 0.3)/2, 1e-9)
+	assert.InDelta(t, 0.04, HalfThickness(DCA, 0.08, 0.5), 1e-9)
+	assert.InDelta(t, 0, HalfThickness(DCA, 0.08, 0), 1e-9)
+}
+
+func TestDeviationCoefs(t *testing.T) {
+	var beta1, beta2 = 50 * math.Pi / 180, 30 * math.Pi / 180
+	for _, family := range []string{C4, DCA} {
+		var m, b = DeviationCoefs(family, beta1, beta2)
+		assert.InDelta(t, 0.29, m, 1e-12)
+		assert.InDelta(t, 0.5, b, 1e-12)
+	}
+
+	// x = beta1 / 100 = 0.5
+	var m, b = DeviationCoefs(NACA65, beta1, beta2)
+	assert.InDelta(t, 0.23660, m, 1e-5)
+	assert.InDelta(t, 0.77125, b, 1e-5)
+}
+
+func TestReferenceDeviation(t *testing.T) {
+	var beta1 = 50 * math.Pi / 180
+
+	// при c_max / b = 0.1 поправка на толщину равна единице:
+	// (delta0)_10 = 0.01 sigma beta1 + (0.74 sigma^1.9 + 3 sigma) (beta1 / 90)^(1.67 + 1.09 sigma)
+	assert.InDelta(t, 1.23845, toDegrees(ReferenceDeviation(NACA65, 0.1, 1, beta1)), 1e-5)
+	assert.InDelta(t, 1.40707, toDegrees(ReferenceDeviation(NACA65, 0.1, 1.2, beta1)), 1e-5)
+
+	// (K_delta)_t = 6.25 * 0.05 + 37.5 * 0.05^2 = 0.40625
+	assert.InDelta(t, 0.40625*1.23845, toDegrees(ReferenceDeviation(NACA65, 0.05, 1, beta1)), 1e-5)
+	assert.InDelta(t, 0, ReferenceDeviation(NACA65, 0.1, 1, 0), 1e-12)
+
+	for _, family := range []string{C4, DCA} {
+		assert.Equal(t, 0., ReferenceDeviation(family, 0.1, 1, beta1))
+	}
+}
+
+func TestNewCompressorSection(t *testing.T) {
+	var conf = CompressorBladeConfig{Family: C4, ThicknessRel: 0.08, Solidity: 1.2, PointNum: 41}
+	var beta1, beta2 = 55 * math.Pi / 180, 35 * math.Pi / 180
+
+	var s, err = NewCompressorSection(conf, 0.5, beta1, beta2)
+	assert.NoError(t, err)
+	assert.InDelta(t, s.Camber, s.InletBladeAngle-s.OutletBladeAngle, 1e-12)
+	assert.InDelta(t, beta1-s.Incidence, s.InletBladeAngle, 1e-12)
+	assert.InDelta(t, CarterCoef(beta2)*s.Camber/math.Sqrt(conf.Solidity), s.Deviation, 1e-12)
+	assert.True(t, s.Deviation > 0)
+	assert.True(t, s.Camber > beta1-beta2)
+
+	// кромки профиля лежат на хорде единичной длины под углом выноса
+	var te = s.PS[len(s.PS)-1]
+	assert.InDelta(t, 0, math.Hypot(s.PS[0][0], s.PS[0][1]), 1e-9)
+	assert.InDelta(t, math.Cos(s.Stagger), te[0], 1e-9)
+	assert.InDelta(t, math.Sin(s.Stagger), te[1], 1e-9)
+	assert.Equal(t, 2*conf.PointNum-2, len(s.Contour()))
+
+	q, err := AnalyzeSection(s.Cascade(), QualityLimits{})
+	assert.NoError(t, err)
+	assert.InDelta(t, conf.ThicknessRel, q.MaxThicknessRel, 0.005)
+	assert.InDelta(t, 1/conf.Solidity, q.TRel, 1e-9)
+	assert.InDelta(t, s.Incidence, -q.Incidence, 1e-12)
+
+	// для NACA65 угол отставания задается корреляцией Либлейна
+	conf.Family = NACA65
+	s, err = NewCompressorSection(conf, 0.5, beta1, beta2)
+	assert.NoError(t, err)
+	var m, b = DeviationCoefs(NACA65, beta1, beta2)
+	var delta0 = ReferenceDeviation(NACA65, conf.ThicknessRel, conf.Solidity, beta1)
+	assert.True(t, delta0 > 0)
+	assert.InDelta(t, delta0+m*s.Camber/math.Pow(conf.Solidity, b), s.Deviation, 1e-12)
+	assert.InDelta(t, s.Camber, s.InletBladeAngle-s.OutletBladeAngle, 1e-12)
+
+	conf.Family = "naca00"
+	_, err = NewCompressorSection(conf, 0.5, beta1, beta2)
+	assert.Error(t, err)
+}
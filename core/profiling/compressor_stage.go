@@ -0,0 +1,67 @@
+package profiling
+
+import (
+	"fmt"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
+	"math"
+)
+
+// NewCompressorRowSections строит сечения рабочего колеса или направляющего аппарата ступени компрессора
+// по треугольникам скоростей на среднем радиусе и закону закрутки law
+func NewCompressorRowSections(
+	stage compressor.StageNode,
+	conf CompressorBladeConfig,
+	law LawSpec,
+	isRotor bool,
+	hRelArr []float64,
+) ([]CompressorSection, error) {
+	if law.Name == ConstantLabourLaw {
+		return nil, fmt.Errorf("constant labour law is not supported for compressor rows")
+	}
+	if err := law.Validate(false); err != nil {
+		return nil, err
+	}
+
+	var pack = stage.GetDataPack()
+	var meanInlet, meanOutlet = pack.MidTriangle, pack.OutletTriangle
+	var bladingGeom = pack.StageGeometry.StatorGeometry()
+	if isRotor {
+		meanInlet, meanOutlet = pack.InletTriangle, pack.MidTriangle
+		bladingGeom = pack.StageGeometry.RotorGeometry()
+	}
+	var lRel = bladeLengthRel(bladingGeom)
+	var velocityLaw = NewVelocityLaw(law)
+
+	var result = make([]CompressorSection, len(hRelArr))
+	for i, hRel := range hRelArr {
+		var inlet = velocityLaw.InletTriangle(meanInlet, hRel, lRel)
+		var outlet = velocityLaw.OutletTriangle(meanOutlet, hRel, lRel)
+		var inletAngle, outletAngle = inlet.Alpha(), outlet.Alpha()
+		if isRotor {
+			inletAngle, outletAngle = inlet.Beta(), outlet.Beta()
+		}
+
+		var section, err = NewCompressorSection(conf, hRel, math.Pi/2-inletAngle, math.Pi/2-outletAngle)
+		if err != nil {
+			return nil, fmt.Errorf("h_rel = %.2f: %v", hRel, err)
+		}
+		result[i] = section
+	}
+	return result, nil
+}
+
+// bladeLengthRel возвращает отношение длины лопатки к среднему диаметру на входе в венец
+func bladeLengthRel(bladingGeom geometry.BladingGeometry) float64 {
+	var dOut = bladingGeom.OuterProfile().Diameter(0)
+	var dIn = bladingGeom.InnerProfile().Diameter(0)
+	return (dOut - dIn) / (dOut + dIn)
+}
+
+// bladeLengthRelOut возвращает отношение длины лопатки к среднему диаметру на выходе из венца
+func bladeLengthRelOut(bladingGeom geometry.BladingGeometry) float64 {
+	var x = bladingGeom.XGapOut()
+	var dOut = bladingGeom.OuterProfile().Diameter(x)
+	var dIn = bladingGeom.InnerProfile().Diameter(x)
+	return (dOut - dIn) / (dOut + dIn)
+}
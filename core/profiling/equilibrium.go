@@ -0,0 +1,204 @@
+package profiling
+
+import (
+	"fmt"
+	"math"
+)
+
+// StationConditions - осредненные параметры в осевом зазоре, к которым привязывается решение
+type StationConditions struct {
+	RHub    float64
+	RTip    float64
+	CAMean  float64 // осевая скорость, осредненная по площади (условие неразрывности)
+	Density float64
+	PMean   float64 // статическое давление на среднем радиусе
+}
+
+// Station - распределение параметров по высоте в осевом зазоре
+type Station struct {
+	HRel []float64
+	R    []float64
+	CA   []float64
+	CU   []float64
+	P    []float64
+	H0   []float64 // полная энтальпия, отсчитанная от полной энтальпии на входе в ступень
+}
+
+// At возвращает окружную и осевую составляющие скорости на относительной высоте hRel
+func (s Station) At(hRel float64) (cu, ca float64) {
+	return interpTable(s.HRel, s.CU, hRel), interpTable(s.HRel, s.CA, hRel)
+}
+
+// EquilibriumConfig - исходные данные для расчета ступени по уравнению простого радиального равновесия.
+// Функции задаются по относительной высоте лопатки.
+type EquilibriumConfig struct {
+	PointNum int
+	Omega    float64
+
+	InletSwirl func(hRel float64) float64 // c_u1 за сопловым аппаратом (направляющим аппаратом)
+	Work       func(hRel float64) float64 // h0_2 - h0_1: положительна для компрессора, отрицательна для турбины
+	StatorLoss func(hRel float64) float64 // потери в сопловом аппарате T ds, Дж/кг
+	RotorLoss  func(hRel float64) float64 // потери в рабочем колесе T ds, Дж/кг
+
+	Inlet  StationConditions // за сопловым аппаратом
+	Outlet StationConditions // за рабочим колесом
+}
+
+type StageEquilibrium struct {
+	Inlet      Station
+	Outlet     Station
+	Reactivity []float64 // (h_2 - h_1) / (h0_2 - h0_1) по высоте
+	Warnings   []string
+}
+
+func SolveStageEquilibrium(conf EquilibriumConfig) (StageEquilibrium, error) {
+	if conf.PointNum < 3 {
+		return StageEquilibrium{}, fmt.Errorf("at least 3 points required, got %d", conf.PointNum)
+	}
+	if conf.Omega == 0 {
+		return StageEquilibrium{}, fmt.Errorf("angular velocity must be nonzero")
+	}
+
+	var zero = func(hRel float64) float64 { return 0 }
+	var inlet, err = SolveStation(conf.Inlet, conf.PointNum, zero, conf.StatorLoss, conf.InletSwirl)
+	if err != nil {
+		return StageEquilibrium{}, fmt.Errorf("inlet station: %v", err)
+	}
+
+	// закрутка за колесом из уравнения Эйлера при равных радиусах линии тока в относительных высотах
+	var outletSwirl = func(hRel float64) float64 {
+		var r = lerp(conf.Outlet.RHub, conf.Outlet.RTip, hRel)
+		var rIn = lerp(conf.Inlet.RHub, conf.Inlet.RTip, hRel)
+		return (rIn*conf.InletSwirl(hRel) + conf.Work(hRel)/conf.Omega) / r
+	}
+	var totalLoss = func(hRel float64) float64 {
+		return conf.StatorLoss(hRel) + conf.RotorLoss(hRel)
+	}
+	outlet, err := SolveStation(conf.Outlet, conf.PointNum, conf.Work, totalLoss, outletSwirl)
+	if err != nil {
+		return StageEquilibrium{}, fmt.Errorf("outlet station: %v", err)
+	}
+
+	var result = StageEquilibrium{
+		Inlet:      inlet,
+		Outlet:     outlet,
+		Reactivity: make([]float64, conf.PointNum),
+	}
+	for i := range result.Reactivity {
+		var h1 = inlet.H0[i] - (inlet.CA[i]*inlet.CA[i]+inlet.CU[i]*inlet.CU[i])/2
+		var h2 = outlet.H0[i] - (outlet.CA[i]*outlet.CA[i]+outlet.CU[i]*outlet.CU[i])/2
+		var work = outlet.H0[i] - inlet.H0[i]
+		if work == 0 {
+			return StageEquilibrium{}, fmt.Errorf("zero work at h_rel = %.3f", inlet.HRel[i])
+		}
+		result.Reactivity[i] = (h2 - h1) / work
+	}
+	if result.Reactivity[0] < 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf(
+			"negative hub reactivity %.3f", result.Reactivity[0],
+		))
+	}
+	return result, nil
+}
+
+// SolveStation решает уравнение простого радиального равновесия
+// d(c_a^2 / 2) / dr = dh0 / dr - T ds / dr - c_u / r d(r c_u) / dr. Осевая скорость у втулки
+// подбирается из условия неразрывности, статическое давление - из условия dp / dr = rho c_u^2 / r.
+func SolveStation(
+	cond StationConditions, pointNum int,
+	h0, loss, swirl func(hRel float64) float64,
+) (Station, error) {
+	if cond.RTip <= cond.RHub || cond.RHub <= 0 {
+		return Station{}, fmt.Errorf("invalid station radii: hub %.4f, tip %.4f", cond.RHub, cond.RTip)
+	}
+	if cond.CAMean <= 0 {
+		return Station{}, fmt.Errorf("mean axial velocity must be positive, got %.3f", cond.CAMean)
+	}
+
+	var s = Station{
+		HRel: make([]float64, pointNum),
+		R:    make([]float64, pointNum),
+		CA:   make([]float64, pointNum),
+		CU:   make([]float64, pointNum),
+		P:    make([]float64, pointNum),
+		H0:   make([]float64, pointNum),
+	}
+	var g = make([]float64, pointNum)
+	for i := range s.HRel {
+		var hRel = float64(i) / float64(pointNum-1)
+		s.HRel[i] = hRel
+		s.R[i] = lerp(cond.RHub, cond.RTip, hRel)
+		s.CU[i] = swirl(hRel)
+		s.H0[i] = h0(hRel)
+		g[i] = s.H0[i] - loss(hRel)
+	}
+
+	var d = equilibriumIncrements(s.R, s.CU, g)
+	var dMin = 0.
+	for _, di := range d {
+		dMin = math.Min(dMin, di)
+	}
+
+	var meanCA = func(ca2Hub float64) float64 {
+		var num, den = 0., 0.
+		for i := 1; i < pointNum; i++ {
+			var ca0 = math.Sqrt(math.Max(ca2Hub+d[i-1], 0))
+			var ca1 = math.Sqrt(math.Max(ca2Hub+d[i], 0))
+			var dr = s.R[i] - s.R[i-1]
+			num += (ca0*s.R[i-1] + ca1*s.R[i]) / 2 * dr
+			den += (s.R[i-1] + s.R[i]) / 2 * dr
+		}
+		return num / den
+	}
+
+	var lo = -dMin
+	if meanCA(lo) >= cond.CAMean {
+		return Station{}, fmt.Errorf(
+			"no solution with positive axial velocity for mean axial velocity %.3f", cond.CAMean,
+		)
+	}
+	var hi = lo + cond.CAMean*cond.CAMean
+	for meanCA(hi) < cond.CAMean {
+		hi = lo + 2*(hi-lo)
+	}
+	for iter := 0; iter != 100; iter++ {
+		var mid = (lo + hi) / 2
+		if meanCA(mid) < cond.CAMean {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	var ca2Hub = (lo + hi) / 2
+	for i := range s.CA {
+		s.CA[i] = math.Sqrt(math.Max(ca2Hub+d[i], 0))
+	}
+
+	for i := 1; i < pointNum; i++ {
+		var f0 = s.CU[i-1] * s.CU[i-1] / s.R[i-1]
+		var f1 = s.CU[i] * s.CU[i] / s.R[i]
+		s.P[i] = s.P[i-1] + cond.Density*(f0+f1)/2*(s.R[i]-s.R[i-1])
+	}
+	var pShift = cond.PMean - interpTable(s.R, s.P, (cond.RHub+cond.RTip)/2)
+	for i := range s.P {
+		s.P[i] += pShift
+	}
+	return s, nil
+}
+
+// equilibriumIncrements интегрирует уравнение простого радиального равновесия
+// d(c_a^2) = 2 dg - 2 c_u / r d(r c_u), g = h0 - T ds, и возвращает приращения квадрата
+// осевой скорости относительно первой точки. Радиусы могут как возрастать, так и убывать.
+func equilibriumIncrements(r, cu, g []float64) []float64 {
+	var d = make([]float64, len(r))
+	for i := 1; i < len(r); i++ {
+		var cuR = (cu[i]/r[i] + cu[i-1]/r[i-1]) / 2
+		var dRCU = r[i]*cu[i] - r[i-1]*cu[i-1]
+		d[i] = d[i-1] + 2*((g[i]-g[i-1])-cuR*dRCU)
+	}
+	return d
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
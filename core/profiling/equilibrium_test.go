@@ -0,0 +1,102 @@
+package profiling
+
+import (
+	"github.com/Sovianum/turbocycle/impl/stage/states"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+var testStation = StationConditions{RHub: 0.2, RTip: 0.3, CAMean: 150, Density: 1.5, PMean: 1e5}
+
+func TestSolveStation_FreeVortex(t *testing.T) {
+	var k = 200 * 0.25 // c_u r
+	var s, err = SolveStation(testStation, 51, constFunc(0), constFunc(0), func(hRel float64) float64 {
+		return k / lerp(testStation.RHub, testStation.RTip, hRel)
+	})
+	assert.NoError(t, err)
+	for i, r := range s.R {
+		assert.InDelta(t, testStation.CAMean, s.CA[i], 1e-6)
+
+		var expectedP = testStation.PMean + testStation.Density*k*k/2*(1/(0.25*0.25)-1/(r*r))
+		assert.InDelta(t, expectedP, s.P[i], 5)
+	}
+}
+
+func TestSolveStation_SolidBody(t *testing.T) {
+	var a = 500.
+	var s, err = SolveStation(testStation, 201, constFunc(0), constFunc(0), func(hRel float64) float64 {
+		return a * lerp(testStation.RHub, testStation.RTip, hRel)
+	})
+	assert.NoError(t, err)
+
+	var num, den = 0., 0.
+	for i, r := range s.R {
+		var expected = s.CA[0]*s.CA[0] - 2*a*a*(r*r-s.R[0]*s.R[0])
+		assert.InDelta(t, expected, s.CA[i]*s.CA[i], 50)
+		num += s.CA[i] * r
+		den += r
+	}
+	assert.InDelta(t, testStation.CAMean, num/den, 0.5)
+
+	_, err = SolveStation(testStation, 51, constFunc(0), constFunc(0), constFunc(1e4))
+	assert.Error(t, err)
+}
+
+func TestSolveStageEquilibrium(t *testing.T) {
+	var omega = 1000.
+	var conf = EquilibriumConfig{
+		PointNum: 21,
+		Omega:    omega,
+		InletSwirl: func(hRel float64) float64 {
+			return 300 * 0.25 / lerp(testStation.RHub, testStation.RTip, hRel)
+		},
+		Work:       constFunc(-5e4),
+		StatorLoss: constFunc(2e3),
+		RotorLoss:  constFunc(3e3),
+		Inlet:      testStation,
+		Outlet:     testStation,
+	}
+	var result, err = SolveStageEquilibrium(conf)
+	assert.NoError(t, err)
+
+	// при постоянной работе и свободном вихре на входе закрутка за колесом также следует свободному вихрю
+	for i, r := range result.Outlet.R {
+		assert.InDelta(t, result.Inlet.CU[i]*result.Inlet.R[i]-5e4/omega, result.Outlet.CU[i]*r, 1e-9)
+		assert.InDelta(t, testStation.CAMean, result.Outlet.CA[i], 1e-6)
+	}
+	assert.True(t, result.Reactivity[0] < result.Reactivity[len(result.Reactivity)-1])
+	assert.Equal(t, 1, len(result.Warnings))
+
+	assert.InDelta(t, (300*0.25-5e4/omega)/0.25, result.Outlet.CU[10], 1e-9)
+
+	var cu, ca = result.Outlet.At(0.5)
+	assert.InDelta(t, result.Outlet.CU[10], cu, 1e-9)
+	assert.InDelta(t, result.Outlet.CA[10], ca, 1e-9)
+}
+
+func TestNewStationLaw(t *testing.T) {
+	var k = 200 * 0.25 // c_u r
+	var station, err = SolveStation(testStation, 51, constFunc(0), constFunc(0), func(hRel float64) float64 {
+		return k / lerp(testStation.RHub, testStation.RTip, hRel)
+	})
+	assert.NoError(t, err)
+
+	var lRel = (testStation.RTip - testStation.RHub) / (testStation.RTip + testStation.RHub)
+	var mean = states.NewOutletTriangle(250, 250, math.Pi/4)
+	var law = NewStationLaw(station)
+	for _, i := range []int{0, 25, 50} {
+		var hRel = station.HRel[i]
+		for _, triangle := range []states.VelocityTriangle{
+			law.InletTriangle(mean, hRel, lRel), law.OutletTriangle(mean, hRel, lRel),
+		} {
+			assert.InDelta(t, station.CU[i], triangle.C()*math.Cos(triangle.Alpha()), 1e-6, "h_rel = %v", hRel)
+			assert.InDelta(t, station.CA[i], triangle.C()*math.Sin(triangle.Alpha()), 1e-6, "h_rel = %v", hRel)
+			assert.InDelta(t, 250*station.R[i]/0.25, triangle.U(), 1e-6, "h_rel = %v", hRel)
+		}
+	}
+}
+
+func constFunc(value float64) func(float64) float64 {
+	return func(float64) float64 { return value }
+}
@@ -0,0 +1,286 @@
+package profiling
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	igesDataWidth  = 72
+	igesParamWidth = 64
+	igesPointCurve = 106
+	igesPolyline3D = 12
+
+	// igesDate - фиксированная дата создания файла: одинаковая геометрия дает побайтно одинаковый файл,
+	// и контрольные суммы в манифесте запуска не меняются
+	igesDate = "20000101.000000"
+)
+
+func SaveGridCSV(path string, grid BladeGrid) error {
+	return saveGrid(path, grid, WriteGridCSV)
+}
+
+func SaveSTL(path string, grid BladeGrid) error {
+	return saveGrid(path, grid, WriteSTL)
+}
+
+func SaveIGES(path string, grid BladeGrid) error {
+	return saveGrid(path, grid, WriteIGES)
+}
+
+// WriteGridCSV записывает сетку построчно: номер сечения, номер точки, x, y, z
+func WriteGridCSV(w io.Writer, grid BladeGrid) error {
+	for i, section := range grid {
+		for j, p := range section {
+			if _, err := fmt.Fprintf(w, "%d,%d,%f,%f,%f\n", i, j, p[0], p[1], p[2]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteSTL записывает замкнутую поверхность лопатки (боковая поверхность и торцы) в формате ASCII STL
+func WriteSTL(w io.Writer, grid BladeGrid) error {
+	if _, err := io.WriteString(w, "solid blade\n"); err != nil {
+		return err
+	}
+	for _, facet := range gridFacets(grid) {
+		if err := writeFacet(w, facet); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "endsolid blade\n")
+	return err
+}
+
+// WriteIGES записывает каждое сечение как кривую по точкам (IGES entity 106, form 12)
+func WriteIGES(w io.Writer, grid BladeGrid) error {
+	var start = []string{"blade sections as 3D point curves"}
+	var global = igesGlobalSection()
+
+	var directory []string
+	var params []string
+	for i, section := range grid {
+		var deSeq = 2*i + 1
+		var paramLines = igesParamLines(igesCurveParams(section), deSeq)
+		var paramStart = len(params) + 1
+
+		directory = append(directory,
+			fmt.Sprintf("%8d%8d%8d%8d%8d%8d%8d%8d%8s",
+				igesPointCurve, paramStart, 0, 0, 0, 0, 0, 0, "00000000",
+			),
+			fmt.Sprintf("%8d%8d%8d%8d%8d%8s%8s%8s%8d",
+				igesPointCurve, 0, 0, len(paramLines), igesPolyline3D, "", "", fmt.Sprintf("SEC%d", i), 0,
+			),
+		)
+		params = append(params, paramLines...)
+	}
+
+	var bw = bufio.NewWriter(w)
+	for _, block := range []struct {
+		letter string
+		lines  []string
+	}{
+		{"S", start}, {"G", global}, {"D", directory}, {"P", params},
+	} {
+		for j, line := range block.lines {
+			fmt.Fprintf(bw, "%-72s%s%7d\n", line, block.letter, j+1)
+		}
+	}
+	var terminate = fmt.Sprintf("S%7dG%7dD%7dP%7d", len(start), len(global), len(directory), len(params))
+	fmt.Fprintf(bw, "%-72s%s%7d\n", terminate, "T", 1)
+	return bw.Flush()
+}
+
+func igesGlobalSection() []string {
+	var fields = []string{
+		"1H,", "1H;",
+		hollerith("blade"), hollerith("blade.igs"), hollerith("cooling-course-project"), hollerith("1.0"),
+		"32", "38", "6", "308", "15",
+		hollerith("blade"),
+		"1.0", "6", hollerith("M"), "1", "0.0",
+		hollerith(igesDate), "1.0E-6", "0.0",
+		hollerith(""), hollerith(""),
+		"11", "0",
+		hollerith(igesDate),
+	}
+	return wrapFields(fields, igesDataWidth, ",", ";")
+}
+
+func igesCurveParams(section [][]float64) []string {
+	var result = []string{fmt.Sprint(igesPointCurve), "2", fmt.Sprint(len(section))}
+	for _, p := range section {
+		for _, c := range p {
+			result = append(result, fmt.Sprintf("%.6E", c))
+		}
+	}
+	return result
+}
+
+func igesParamLines(fields []string, deSeq int) []string {
+	var lines = wrapFields(fields, igesParamWidth, ",", ";")
+	for i, line := range lines {
+		lines[i] = fmt.Sprintf("%-64s %7d", line, deSeq)
+	}
+	return lines
+}
+
+func wrapFields(fields []string, width int, delim, terminator string) []string {
+	var result []string
+	var line = ""
+	for i, field := range fields {
+		var token = field + delim
+		if i == len(fields)-1 {
+			token = field + terminator
+		}
+		if len(line)+len(token) > width {
+			result = append(result, line)
+			line = ""
+		}
+		line += token
+	}
+	return append(result, line)
+}
+
+func hollerith(s string) string {
+	if s == "" {
+		return ""
+	}
+	return fmt.Sprintf("%dH%s", len(s), s)
+}
+
+func gridFacets(grid BladeGrid) [][3][]float64 {
+	var result [][3][]float64
+	var addFacet = func(a, b, c []float64) {
+		if norm(normal(a, b, c)) > 0 {
+			result = append(result, [3][]float64{a, b, c})
+		}
+	}
+
+	for i := 1; i < len(grid); i++ {
+		var lower, upper = grid[i-1], grid[i]
+		for j := range lower {
+			var k = (j + 1) % len(lower)
+			addFacet(lower[j], lower[k], upper[k])
+			addFacet(lower[j], upper[k], upper[j])
+		}
+	}
+
+	// торцы разбиваются на треугольники в порядке обхода контура, так что их грани
+	// ориентированы согласованно с боковой поверхностью
+	var first, last = grid[0], grid[len(grid)-1]
+	for _, t := range triangulate(first) {
+		addFacet(first[t[2]], first[t[1]], first[t[0]])
+	}
+	for _, t := range triangulate(last) {
+		addFacet(last[t[0]], last[t[1]], last[t[2]])
+	}
+	return result
+}
+
+// triangulate разбивает простой многоугольник на треугольники отсечением ушей.
+// Сечения профилей не являются звездными относительно центра тяжести, поэтому веер треугольников
+// из центра для них не годится. Тройки индексов возвращаются в порядке обхода многоугольника.
+func triangulate(points [][]float64) [][3]int {
+	var orientation = 1.
+	if signedArea(points) < 0 {
+		orientation = -1
+	}
+	var idx = make([]int, len(points))
+	for i := range idx {
+		idx[i] = i
+	}
+
+	var result = make([][3]int, 0, len(points)-2)
+	for len(idx) > 3 {
+		var n = len(idx)
+		var ear = 0 // для вырожденного контура без ушей отсекается первая вершина
+		for i := range idx {
+			if isEar(points, idx, (i+n-1)%n, i, (i+1)%n, orientation) {
+				ear = i
+				break
+			}
+		}
+		result = append(result, [3]int{idx[(ear+n-1)%n], idx[ear], idx[(ear+1)%n]})
+		idx = append(idx[:ear], idx[ear+1:]...)
+	}
+	return append(result, [3]int{idx[0], idx[1], idx[2]})
+}
+
+func isEar(points [][]float64, idx []int, i, j, k int, orientation float64) bool {
+	var a, b, c = points[idx[i]], points[idx[j]], points[idx[k]]
+	if orientation*cross2(a, b, c) <= 0 {
+		return false
+	}
+	for m, id := range idx {
+		if m == i || m == j || m == k {
+			continue
+		}
+		var p = points[id]
+		if orientation*cross2(a, b, p) >= 0 && orientation*cross2(b, c, p) >= 0 && orientation*cross2(c, a, p) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func cross2(a, b, c []float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+func signedArea(points [][]float64) float64 {
+	var result = 0.
+	for i := range points {
+		var p, q = points[i], points[(i+1)%len(points)]
+		result += p[0]*q[1] - q[0]*p[1]
+	}
+	return result / 2
+}
+
+func writeFacet(w io.Writer, facet [3][]float64) error {
+	var n = normal(facet[0], facet[1], facet[2])
+	var l = norm(n)
+	var lines = []string{
+		fmt.Sprintf("facet normal %e %e %e", n[0]/l, n[1]/l, n[2]/l),
+		"outer loop",
+	}
+	for _, p := range facet {
+		lines = append(lines, fmt.Sprintf("vertex %e %e %e", p[0], p[1], p[2]))
+	}
+	lines = append(lines, "endloop", "endfacet")
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+func normal(a, b, c []float64) []float64 {
+	var u = []float64{b[0] - a[0], b[1] - a[1], b[2] - a[2]}
+	var v = []float64{c[0] - a[0], c[1] - a[1], c[2] - a[2]}
+	return []float64{
+		u[1]*v[2] - u[2]*v[1],
+		u[2]*v[0] - u[0]*v[2],
+		u[0]*v[1] - u[1]*v[0],
+	}
+}
+
+func norm(v []float64) float64 {
+	return math.Sqrt(v[0]*v[0] + v[1]*v[1] + v[2]*v[2])
+}
+
+func saveGrid(path string, grid BladeGrid, writeFunc func(io.Writer, BladeGrid) error) error {
+	var f, err = os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var w = bufio.NewWriter(f)
+	if err := writeFunc(w, grid); err != nil {
+		return err
+	}
+	return w.Flush()
+}
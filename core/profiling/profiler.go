@@ -1,7 +1,8 @@
 package profiling
 
 import (
-	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
 	"github.com/Sovianum/turbocycle/impl/stage/states"
 	"github.com/Sovianum/turbocycle/impl/stage/turbine"
 	"github.com/Sovianum/turbocycle/utils/turbine/radial/laws"
@@ -12,68 +13,57 @@ func GetInitedStatorProfiler(
 	geomGen turbine.BladingGeometryGenerator,
 	meanInletTriangle, meanOutletTriangle states.VelocityTriangle,
 ) profilers.Profiler {
-	return profilers.NewProfiler(
-		profilers.ProfilerConfig{
-			Windage:    1,
-			ApproxTRel: 0.7,
-
-			Behavior: profilers.NewStatorProfilingBehavior(),
-			GeomGen:  geomGen,
-
-			MeanInletTriangle:  meanInletTriangle,
-			MeanOutletTriangle: meanOutletTriangle,
-			InletVelocityLaw:   laws.NewConstantAbsoluteAngleLaw(),
-			OutletVelocityLaw:  laws.NewConstantAbsoluteAngleLaw(),
-
-			InletProfileAngleFunc: func(characteristicAngle, hRel float64) float64 {
-				return characteristicAngle
-			},
-			OutletProfileAngleFunc: func(characteristicAngle, hRel float64) float64 {
-				return characteristicAngle
-			},
-
-			InstallationAngleFunc: func(hRel float64) float64 {
-				return common.InterpTolerate(
-					hRel,
-					[]float64{0, 1},
-					[]float64{common.ToRadians(50), common.ToRadians(50)},
-				)
-			},
-
-			InletExpansionAngleFunc: func(hRel float64) float64 {
-				return common.InterpTolerate(
-					hRel,
-					[]float64{0, 1},
-					[]float64{common.ToRadians(15), common.ToRadians(30)},
-				)
-			},
-			OutletExpansionAngleFunc: func(hRel float64) float64 {
-				return common.ToRadians(5)
-			},
-
-			InletPSAngleFractionFunc: func(hRel float64) float64 {
-				return 0.5
-			},
-			OutletPSAngleFractionFunc: func(hRel float64) float64 {
-				return 1 / 3
-			},
-		},
-	)
+	var profiler, err = NewProfiler(DefaultStatorSpec(), geomGen, meanInletTriangle, meanOutletTriangle)
+	if err != nil {
+		panic(err)
+	}
+	return profiler
 }
 
 func GetInitedRotorProfiler(
 	geomGen turbine.BladingGeometryGenerator,
 	meanInletTriangle, meanOutletTriangle states.VelocityTriangle,
 ) profilers.Profiler {
-	var inletLaw = laws.NewConstantAbsoluteAngleLaw()
-	var outletLaw = laws.NewConstantLabourLaw(inletLaw, meanInletTriangle)
+	var profiler, err = NewProfiler(DefaultRotorSpec(), geomGen, meanInletTriangle, meanOutletTriangle)
+	if err != nil {
+		panic(err)
+	}
+	return profiler
+}
 
+func NewProfiler(
+	spec ProfilerSpec,
+	geomGen turbine.BladingGeometryGenerator,
+	meanInletTriangle, meanOutletTriangle states.VelocityTriangle,
+) (profilers.Profiler, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	var inlet, outlet = meanVelocity(meanInletTriangle), meanVelocity(meanOutletTriangle)
+	if err := spec.Check(inlet, outlet, geomGen.LRelOut()); err != nil {
+		return nil, err
+	}
+
+	var behavior = profilers.NewStatorProfilingBehavior()
+	if spec.Kind == RotorKind {
+		behavior = profilers.NewRotorProfilingBehavior()
+	}
+
+	var inletLaw = NewVelocityLaw(spec.InletLaw)
+	var outletLaw laws.VelocityLaw
+	if spec.OutletLaw.Name == ConstantLabourLaw {
+		outletLaw = laws.NewConstantLabourLaw(inletLaw, meanInletTriangle)
+	} else {
+		outletLaw = NewVelocityLaw(spec.OutletLaw)
+	}
+
+	var inletShift, outletShift = spec.InletAngleShift.Radians(), spec.OutletAngleShift.Radians()
 	return profilers.NewProfiler(
 		profilers.ProfilerConfig{
-			Windage:    1,
-			ApproxTRel: 0.7,
+			Windage:    spec.Windage,
+			ApproxTRel: spec.ApproxTRel,
 
-			Behavior: profilers.NewRotorProfilingBehavior(),
+			Behavior: behavior,
 			GeomGen:  geomGen,
 
 			MeanInletTriangle:  meanInletTriangle,
@@ -82,49 +72,66 @@ func GetInitedRotorProfiler(
 			OutletVelocityLaw:  outletLaw,
 
 			InletProfileAngleFunc: func(characteristicAngle, hRel float64) float64 {
-				return characteristicAngle + common.ToRadians(2)
+				return characteristicAngle + inletShift(hRel)
 			},
 			OutletProfileAngleFunc: func(characteristicAngle, hRel float64) float64 {
-				return characteristicAngle
+				return characteristicAngle + outletShift(hRel)
 			},
 
-			InstallationAngleFunc: func(hRel float64) float64 {
-				return common.InterpTolerate(
-					hRel,
-					[]float64{0, 0.5, 1},
-					[]float64{
-						common.ToRadians(68),
-						common.ToRadians(55),
-						common.ToRadians(50),
-					},
-				)
-			},
+			InstallationAngleFunc:     spec.InstallationAngle.Radians(),
+			InletExpansionAngleFunc:   spec.InletExpansionAngle.Radians(),
+			OutletExpansionAngleFunc:  spec.OutletExpansionAngle.Radians(),
+			InletPSAngleFractionFunc:  spec.InletPSAngleFraction.At,
+			OutletPSAngleFractionFunc: spec.OutletPSAngleFraction.At,
+		},
+	), nil
+}
 
-			InletExpansionAngleFunc: func(hRel float64) float64 {
-				return common.InterpTolerate(
-					hRel,
-					[]float64{0, 1},
-					[]float64{common.ToRadians(20), common.ToRadians(15)},
-				)
-			},
-			OutletExpansionAngleFunc: func(hRel float64) float64 {
-				return common.ToRadians(5)
-			},
+// NewTurbineStageProfiler строит профайлер соплового аппарата или рабочего колеса ступени турбины
+func NewTurbineStageProfiler(spec ProfilerSpec, stage turbine.StageNode) (profilers.Profiler, error) {
+	var pack = stage.GetDataPack()
+	if pack.Err != nil {
+		return nil, pack.Err
+	}
+	if spec.Kind == RotorKind {
+		return NewProfiler(
+			spec, stage.StageGeomGen().RotorGenerator(), pack.RotorInletTriangle, pack.RotorOutletTriangle,
+		)
+	}
+	return NewProfiler(
+		spec, stage.StageGeomGen().StatorGenerator(),
+		stage.VelocityInput().GetState().(states.VelocityPortState).Triangle, pack.RotorInletTriangle,
+	)
+}
 
-			InletPSAngleFractionFunc: func(hRel float64) float64 {
-				return common.InterpTolerate(
-					hRel,
-					[]float64{0, 1},
-					[]float64{0.7, 0.9},
-				)
-			},
-			OutletPSAngleFractionFunc: func(hRel float64) float64 {
-				return common.InterpTolerate(
-					hRel,
-					[]float64{0, 1},
-					[]float64{1 / 3, 1},
-				)
-			},
-		},
+// NewCompressorStageProfiler строит профайлер рабочего колеса или направляющего аппарата ступени компрессора
+func NewCompressorStageProfiler(spec ProfilerSpec, stage compressor.StageNode) (profilers.Profiler, error) {
+	var pack = stage.GetDataPack()
+	if pack.Err != nil {
+		return nil, pack.Err
+	}
+	if spec.Kind == RotorKind {
+		return NewProfiler(
+			spec,
+			compressorGeomGen(stage.GeomGen().RotorGenerator(), pack.StageGeometry.RotorGeometry(), spec.ApproxTRel),
+			pack.InletTriangle, pack.MidTriangle,
+		)
+	}
+	return NewProfiler(
+		spec,
+		compressorGeomGen(stage.GeomGen().StatorGenerator(), pack.StageGeometry.StatorGeometry(), spec.ApproxTRel),
+		pack.MidTriangle, pack.OutletTriangle,
+	)
+}
+
+// compressorGeomGen приводит генератор геометрии венца компрессора к генератору турбины,
+// с которым работает профайлер: параметры венца переносятся без изменений,
+// относительная длина лопатки на выходе берется из построенной геометрии венца
+func compressorGeomGen(
+	gen compressor.BladingGeometryGenerator, bladingGeom geometry.BladingGeometry, approxTRel float64,
+) turbine.BladingGeometryGenerator {
+	var incomplete = turbine.NewIncompleteGenerator(
+		gen.Elongation(), gen.DeltaRel(), gen.GammaIn(), gen.GammaOut(), approxTRel,
 	)
+	return turbine.NewStageGeometryGenerator(bladeLengthRelOut(bladingGeom), incomplete, incomplete).StatorGenerator()
 }
@@ -0,0 +1,89 @@
+package profiling
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/engine/states"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+	"github.com/Sovianum/turbocycle/material/gases"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func newTestCompressorStage(t *testing.T) compressor.StageNode {
+	var conf = midall.CompressorConfig{
+		StageNum: 1,
+		RPM:      1e4,
+		DRelIn:   0.5,
+
+		RotorElongationArr:  []float64{3.5},
+		DeltaRotorRelArr:    []float64{0.1},
+		StatorElongationArr: []float64{3.5},
+		DeltaStatorRelArr:   []float64{0.1},
+		GammaInArr:          []float64{common.ToRadians(5)},
+		GammaOutArr:         []float64{common.ToRadians(-5)},
+
+		HtMax:       0.25,
+		HtLimit:     0.5,
+		EtaMax:      0.86,
+		EtaLimit:    0.9,
+		HasPreTwist: true,
+
+		ReactivityStart: 0.5,
+		ReactivityEnd:   0.5,
+		CaStart:         0.5,
+		CaEnd:           0.5,
+		LabourCoef:      0.99,
+
+		Precision:  1e-6,
+		RelaxCoef:  0.1,
+		InitLambda: 1,
+		IterLimit:  1000,
+	}
+	var staged, err = conf.GetStagedCompressor()
+	require.NoError(t, err)
+
+	staged.GasInput().SetState(states.NewGasPortState(gases.GetAir()))
+	staged.TemperatureInput().SetState(states.NewTemperaturePortState(288))
+	staged.PressureInput().SetState(states.NewPressurePortState(1e5))
+	staged.MassRateInput().SetState(states.NewMassRatePortState(50))
+	require.NoError(t, staged.Process())
+	return staged.Stages()[0]
+}
+
+func TestNewCompressorStageProfiler(t *testing.T) {
+	var stage = newTestCompressorStage(t)
+	var pack = stage.GetDataPack()
+
+	for _, isRotor := range []bool{false, true} {
+		var spec = DefaultStatorSpec()
+		var meanIn, meanOut = pack.MidTriangle, pack.OutletTriangle
+		var bladingGeom = pack.StageGeometry.StatorGeometry()
+		if isRotor {
+			spec = DefaultRotorSpec()
+			meanIn, meanOut = pack.InletTriangle, pack.MidTriangle
+			bladingGeom = pack.StageGeometry.RotorGeometry()
+		}
+		// лопатки компрессора закручиваются по закону постоянства циркуляции на обеих кромках
+		spec.InletLaw = LawSpec{Name: FreeVortexLaw}
+		spec.OutletLaw = LawSpec{Name: FreeVortexLaw}
+
+		var profiler, err = NewCompressorStageProfiler(spec, stage)
+		require.NoError(t, err, "rotor: %v", isRotor)
+
+		var inlet, outlet = profiler.InletTriangle(0.5), profiler.OutletTriangle(0.5)
+		assert.InDelta(t, meanIn.C(), inlet.C(), 1e-3*meanIn.C(), "rotor: %v", isRotor)
+		assert.InDelta(t, meanIn.Alpha(), inlet.Alpha(), 1e-3, "rotor: %v", isRotor)
+		assert.InDelta(t, meanOut.C(), outlet.C(), 1e-3*meanOut.C(), "rotor: %v", isRotor)
+		assert.InDelta(t, meanOut.Alpha(), outlet.Alpha(), 1e-3, "rotor: %v", isRotor)
+
+		var geomGen = stage.GeomGen().StatorGenerator()
+		if isRotor {
+			geomGen = stage.GeomGen().RotorGenerator()
+		}
+		var adapted = compressorGeomGen(geomGen, bladingGeom, spec.ApproxTRel)
+		assert.InDelta(t, bladeLengthRelOut(bladingGeom), adapted.LRelOut(), 1e-9)
+		assert.InDelta(t, geomGen.Elongation(), adapted.Elongation(), 1e-9)
+	}
+}
@@ -0,0 +1,204 @@
+package profiling
+
+import (
+	"fmt"
+	"math"
+)
+
+// CascadeSection - сечение решетки профилей. Корыто и спинка заданы точками от входной кромки
+// к выходной, Pitch - вектор сдвига к соседнему профилю. Углы отсчитываются от фронта решетки.
+type CascadeSection struct {
+	HRel  float64
+	PS    [][]float64
+	SS    [][]float64
+	Pitch []float64
+
+	InletFlowAngle  float64
+	InletBladeAngle float64
+	OutletFlowAngle float64
+}
+
+// QualityLimits - допустимые значения показателей профиля. Нулевое значение отключает проверку.
+type QualityLimits struct {
+	MaxIncidence        float64 // |i|, рад
+	MaxOutletAngleError float64 // |arcsin(o / t) - угол потока на выходе|, рад
+	MinThicknessRel     float64 // c_max / b
+	MaxThicknessRel     float64
+	MinEdgeRadiusRel    float64 // r_кр / b
+	MinAreaRatio        float64 // ширина канала на выходе / ширина канала на входе
+	MaxAreaRatio        float64
+}
+
+type SectionQuality struct {
+	HRel  float64
+	Chord float64
+	Pitch float64
+	TRel  float64
+
+	Throat               float64
+	ThroatRel            float64 // o / t
+	EffectiveOutletAngle float64 // arcsin(o / t)
+	OutletAngleError     float64
+	Incidence            float64
+
+	MaxThicknessRel     float64
+	MaxThicknessPos     float64 // положение максимальной толщины в долях хорды
+	InletEdgeRadiusRel  float64
+	OutletEdgeRadiusRel float64
+
+	AreaRatio float64
+	Passage   [][]float64 // [относительная длина корыта, ширина канала / шаг]
+
+	IncidencePassed   bool
+	OutletAnglePassed bool
+	ThicknessPassed   bool
+	EdgePassed        bool
+	AreaPassed        bool
+}
+
+func (q SectionQuality) Passed() bool {
+	return q.IncidencePassed && q.OutletAnglePassed && q.ThicknessPassed && q.EdgePassed && q.AreaPassed
+}
+
+func AnalyzeSections(sections []CascadeSection, limits QualityLimits) ([]SectionQuality, error) {
+	var result = make([]SectionQuality, len(sections))
+	for i, section := range sections {
+		var q, err = AnalyzeSection(section, limits)
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %v", i, err)
+		}
+		result[i] = q
+	}
+	return result, nil
+}
+
+func AnalyzeSection(section CascadeSection, limits QualityLimits) (SectionQuality, error) {
+	if len(section.PS) < 3 || len(section.SS) < 3 {
+		return SectionQuality{}, fmt.Errorf("profile sides must contain at least 3 points")
+	}
+	if len(section.Pitch) != 2 || math.Hypot(section.Pitch[0], section.Pitch[1]) == 0 {
+		return SectionQuality{}, fmt.Errorf("pitch must be a nonzero 2d vector")
+	}
+
+	var ps, ss = section.PS, section.SS
+	var le, te = ps[0], ps[len(ps)-1]
+	var q = SectionQuality{
+		HRel:  section.HRel,
+		Chord: math.Hypot(te[0]-le[0], te[1]-le[1]),
+		Pitch: math.Hypot(section.Pitch[0], section.Pitch[1]),
+	}
+	q.TRel = q.Pitch / q.Chord
+
+	// спинка соседнего профиля, обращенная к корыту рассматриваемого
+	var side = sideSign(ps, ss, section.Pitch)
+	var neighbourSS = make([][]float64, len(ss))
+	for i, p := range ss {
+		neighbourSS[i] = []float64{p[0] - side*section.Pitch[0], p[1] - side*section.Pitch[1]}
+	}
+
+	var psLength = polylineLength(ps)
+	var s = 0.
+	q.Passage = make([][]float64, len(ps))
+	q.Throat = math.Inf(1)
+	for i, p := range ps {
+		if i > 0 {
+			s += math.Hypot(p[0]-ps[i-1][0], p[1]-ps[i-1][1])
+		}
+		var width = polylineDistance(neighbourSS, p)
+		q.Passage[i] = []float64{s / psLength, width / q.Pitch}
+		q.Throat = math.Min(q.Throat, width)
+
+		var thk = polylineDistance(ss, p)
+		if thk/q.Chord > q.MaxThicknessRel {
+			q.MaxThicknessRel = thk / q.Chord
+			q.MaxThicknessPos = ((p[0]-le[0])*(te[0]-le[0]) + (p[1]-le[1])*(te[1]-le[1])) / (q.Chord * q.Chord)
+		}
+	}
+	// горло может опираться как на выходную кромку рассматриваемого профиля, так и на кромку соседнего
+	for _, p := range neighbourSS {
+		q.Throat = math.Min(q.Throat, polylineDistance(ps, p))
+	}
+	q.ThroatRel = q.Throat / q.Pitch
+	q.EffectiveOutletAngle = math.Asin(math.Min(q.ThroatRel, 1))
+	q.OutletAngleError = q.EffectiveOutletAngle - acuteAngle(section.OutletFlowAngle)
+	q.Incidence = section.InletFlowAngle - section.InletBladeAngle
+	q.AreaRatio = q.Passage[len(ps)-1][1] / q.Passage[0][1]
+
+	var n, m = len(ps), len(ss)
+	q.InletEdgeRadiusRel = Circumradius(ps[1], ps[0], ss[1]) / q.Chord
+	q.OutletEdgeRadiusRel = Circumradius(ps[n-2], ps[n-1], ss[m-2]) / q.Chord
+
+	q.IncidencePassed = limits.MaxIncidence == 0 || math.Abs(q.Incidence) <= limits.MaxIncidence
+	q.OutletAnglePassed = limits.MaxOutletAngleError == 0 || math.Abs(q.OutletAngleError) <= limits.MaxOutletAngleError
+	q.ThicknessPassed = (limits.MinThicknessRel == 0 || q.MaxThicknessRel >= limits.MinThicknessRel) &&
+		(limits.MaxThicknessRel == 0 || q.MaxThicknessRel <= limits.MaxThicknessRel)
+	q.EdgePassed = limits.MinEdgeRadiusRel == 0 ||
+		math.Min(q.InletEdgeRadiusRel, q.OutletEdgeRadiusRel) >= limits.MinEdgeRadiusRel
+	q.AreaPassed = (limits.MinAreaRatio == 0 || q.AreaRatio >= limits.MinAreaRatio) &&
+		(limits.MaxAreaRatio == 0 || q.AreaRatio <= limits.MaxAreaRatio)
+	return q, nil
+}
+
+// Circumradius возвращает радиус окружности, проходящей через три точки (0 для вырожденного случая).
+// Для кромки, очерченной дугой окружности, это ее радиус.
+func Circumradius(a, b, c []float64) float64 {
+	var ab = math.Hypot(b[0]-a[0], b[1]-a[1])
+	var bc = math.Hypot(c[0]-b[0], c[1]-b[1])
+	var ca = math.Hypot(a[0]-c[0], a[1]-c[1])
+	var cross = (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+	if cross == 0 {
+		return 0
+	}
+	return ab * bc * ca / (2 * math.Abs(cross))
+}
+
+// sideSign возвращает +1, если спинка расположена со стороны вектора шага относительно корыта
+func sideSign(ps, ss [][]float64, pitch []float64) float64 {
+	var psX, psY = meanPoint(ps)
+	var ssX, ssY = meanPoint(ss)
+	if (ssX-psX)*pitch[0]+(ssY-psY)*pitch[1] < 0 {
+		return -1
+	}
+	return 1
+}
+
+func meanPoint(points [][]float64) (x, y float64) {
+	for _, p := range points {
+		x += p[0]
+		y += p[1]
+	}
+	return x / float64(len(points)), y / float64(len(points))
+}
+
+func acuteAngle(angle float64) float64 {
+	if angle > math.Pi/2 {
+		return math.Pi - angle
+	}
+	return angle
+}
+
+func polylineLength(points [][]float64) float64 {
+	var result = 0.
+	for i := 1; i < len(points); i++ {
+		result += math.Hypot(points[i][0]-points[i-1][0], points[i][1]-points[i-1][1])
+	}
+	return result
+}
+
+func polylineDistance(points [][]float64, p []float64) float64 {
+	var result = math.Inf(1)
+	for i := 1; i < len(points); i++ {
+		result = math.Min(result, segmentDistance(points[i-1], points[i], p))
+	}
+	return result
+}
+
+func segmentDistance(a, b, p []float64) float64 {
+	var dx, dy = b[0] - a[0], b[1] - a[1]
+	var l2 = dx*dx + dy*dy
+	var t = 0.
+	if l2 > 0 {
+		t = math.Max(0, math.Min(1, ((p[0]-a[0])*dx+(p[1]-a[1])*dy)/l2))
+	}
+	return math.Hypot(a[0]+t*dx-p[0], a[1]+t*dy-p[1])
+}
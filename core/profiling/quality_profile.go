@@ -0,0 +1,67 @@
+package profiling
+
+import (
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/utils/turbine/geom"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
+	"gonum.org/v1/gonum/mat"
+	"math"
+)
+
+// EdgeConfig задает параметры кромок при построении профиля по профайлеру
+type EdgeConfig struct {
+	InletEdgeRadius  float64
+	OutletEdgeRadius float64
+	InletEdgeAngle   float64
+	OutletEdgeAngle  float64
+}
+
+// CascadeProfile строит профиль в системе координат решетки: шаг решетки направлен вдоль оси x
+func CascadeProfile(profiler profilers.Profiler, hRel float64, conf EdgeConfig, isRotor bool) profiles.BladeProfile {
+	var bladeProfile = profiles.NewBladeProfileFromProfiler(
+		hRel,
+		conf.InletEdgeRadius, conf.OutletEdgeRadius,
+		conf.InletEdgeAngle, conf.OutletEdgeAngle,
+		profiler,
+	)
+	var installationAngle = profiler.InstallationAngle(hRel)
+
+	if isRotor {
+		bladeProfile.Transform(geom.Reflection(0))
+	}
+	bladeProfile.Transform(geom.Translation(mat.NewVecDense(2, []float64{-1, 0})))
+	if !isRotor {
+		bladeProfile.Transform(geom.Rotation(installationAngle - math.Pi))
+	} else {
+		bladeProfile.Transform(geom.Rotation(-installationAngle))
+	}
+	return bladeProfile
+}
+
+func NewCascadeSection(
+	profiler profilers.Profiler,
+	geomGen turbine.BladingGeometryGenerator,
+	hRel float64, conf EdgeConfig, isRotor bool, pointNum int,
+) CascadeSection {
+	var bladeProfile = CascadeProfile(profiler, hRel, conf, isRotor)
+	var tArr = common.LinSpace(0, 1, pointNum)
+
+	var inletTriangle, outletTriangle = profiler.InletTriangle(hRel), profiler.OutletTriangle(hRel)
+	var inletFlowAngle, outletFlowAngle = inletTriangle.Alpha(), outletTriangle.Alpha()
+	if isRotor {
+		inletFlowAngle, outletFlowAngle = inletTriangle.Beta(), outletTriangle.Beta()
+	}
+
+	return CascadeSection{
+		HRel:  hRel,
+		PS:    geom.GetCoordinates(tArr, profiles.PSSegment(bladeProfile, 0.5, 0.5)),
+		SS:    geom.GetCoordinates(tArr, profiles.SSSegment(bladeProfile, 0.5, 0.5)),
+		Pitch: []float64{turbine.TRel(hRel, geomGen), 0},
+
+		InletFlowAngle:  inletFlowAngle,
+		InletBladeAngle: profiler.InletProfileAngle(hRel),
+		OutletFlowAngle: outletFlowAngle,
+	}
+}
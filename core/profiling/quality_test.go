@@ -0,0 +1,57 @@
+package profiling
+
+import (
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestAnalyzeSection_PlateCascade(t *testing.T) {
+	// решетка пластин толщиной d с углом установки gamma к фронту и шагом t вдоль оси y
+	var gamma, pitch, d = math.Pi / 6, 0.8, 0.05
+	var dir = []float64{math.Sin(gamma), math.Cos(gamma)}
+	var n = []float64{-math.Cos(gamma), math.Sin(gamma)}
+
+	var ps, ss [][]float64
+	for i := 0; i <= 20; i++ {
+		var s = float64(i) / 20
+		ps = append(ps, []float64{s * dir[0], s * dir[1]})
+		ss = append(ss, []float64{s*dir[0] + d*n[0], s*dir[1] + d*n[1]})
+	}
+	var section = CascadeSection{
+		PS: ps, SS: ss, Pitch: []float64{0, pitch},
+		InletFlowAngle: math.Pi / 2, InletBladeAngle: math.Pi/2 - 0.1, OutletFlowAngle: gamma,
+	}
+
+	var q, err = AnalyzeSection(section, QualityLimits{MaxIncidence: 0.05, MaxThicknessRel: 0.1})
+	assert.NoError(t, err)
+	assert.InDelta(t, 1, q.Chord, 1e-9)
+	assert.InDelta(t, pitch*math.Sin(gamma)-d, q.Throat, 1e-9)
+	assert.InDelta(t, d, q.MaxThicknessRel, 1e-9)
+	assert.InDelta(t, 0.1, q.Incidence, 1e-9)
+
+	assert.False(t, q.IncidencePassed)
+	assert.True(t, q.ThicknessPassed)
+	assert.True(t, q.OutletAnglePassed)
+	assert.False(t, q.Passed())
+
+	// без толщины эффективный угол выхода совпадает с углом установки пластин
+	section.SS = section.PS
+	q, err = AnalyzeSection(section, QualityLimits{})
+	assert.NoError(t, err)
+	assert.InDelta(t, gamma, q.EffectiveOutletAngle, 1e-9)
+	assert.InDelta(t, 0, q.OutletAngleError, 1e-9)
+	assert.True(t, q.Passed())
+
+	_, err = AnalyzeSection(CascadeSection{PS: ps, SS: ss}, QualityLimits{})
+	assert.Error(t, err)
+}
+
+func TestCircumradius(t *testing.T) {
+	var r = 0.3
+	var point = func(phi float64) []float64 {
+		return []float64{1 + r*math.Cos(phi), 2 + r*math.Sin(phi)}
+	}
+	assert.InDelta(t, r, Circumradius(point(0.1), point(0.5), point(2)), 1e-9)
+	assert.Equal(t, 0., Circumradius([]float64{0, 0}, []float64{1, 1}, []float64{2, 2}))
+}
@@ -0,0 +1,84 @@
+package profiling
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	FreeVortexLaw         = "free_vortex" // c_u r = const, c_a = const
+	ConstantAbsoluteAngle = "constant_absolute_angle"
+	ConstantReactivityLaw = "constant_reactivity" // c_u = a r - b / r
+	ForcedVortexLaw       = "forced_vortex"       // c_u = c_u_ср (r / r_ср)^n
+	ConstantLabourLaw     = "constant_labour"     // только для выходного треугольника
+)
+
+// LawSpec описывает закон закрутки по радиусу
+type LawSpec struct {
+	Name       string  `json:"name"`
+	Exponent   float64 `json:"exponent,omitempty"`   // показатель степени для forced_vortex
+	Reactivity float64 `json:"reactivity,omitempty"` // степень реактивности на среднем радиусе для constant_reactivity
+}
+
+func (spec LawSpec) Validate(isOutlet bool) error {
+	switch spec.Name {
+	case FreeVortexLaw, ConstantAbsoluteAngle, ConstantReactivityLaw:
+		return nil
+	case ForcedVortexLaw:
+		if spec.Exponent == 0 {
+			return fmt.Errorf("forced vortex law requires nonzero exponent")
+		}
+		return nil
+	case ConstantLabourLaw:
+		if !isOutlet {
+			return fmt.Errorf("constant labour law can be used only for outlet triangle")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown velocity law \"%s\"", spec.Name)
+	}
+}
+
+// MeanVelocity - окружная и осевая составляющие скорости и окружная скорость на среднем радиусе
+type MeanVelocity struct {
+	CU float64
+	CA float64
+	U  float64
+}
+
+// VortexComponents возвращает окружную и осевую составляющие скорости на относительном
+// радиусе x = r / r_ср. Осевая составляющая находится из условия простого радиального равновесия
+// d(c_a^2)/dr = -2 c_u / r d(r c_u)/dr.
+func VortexComponents(spec LawSpec, cuM, caM, uM, x float64) (cu, ca float64, err error) {
+	var ca2 float64
+	switch spec.Name {
+	case FreeVortexLaw:
+		cu, ca2 = cuM/x, caM*caM
+	case ForcedVortexLaw:
+		var n = spec.Exponent
+		cu = cuM * math.Pow(x, n)
+		if n == -1 {
+			ca2 = caM * caM
+		} else {
+			ca2 = caM*caM - (n+1)/n*cuM*cuM*(math.Pow(x, 2*n)-1)
+		}
+	case ConstantReactivityLaw:
+		var a = uM * (1 - spec.Reactivity)
+		var b = a - cuM
+		cu = a*x - b/x
+		ca2 = caM*caM - 2*a*a*(x*x-1) + 4*a*b*math.Log(x)
+	default:
+		return 0, 0, fmt.Errorf("law \"%s\" is not a vortex law", spec.Name)
+	}
+	if ca2 <= 0 {
+		return 0, 0, fmt.Errorf(
+			"law \"%s\" gives nonpositive axial velocity squared (%.3f) at r/r_mean = %.3f", spec.Name, ca2, x,
+		)
+	}
+	return cu, math.Sqrt(ca2), nil
+}
+
+// RRel переводит относительную высоту в отношение радиуса к среднему при относительной длине l / D_ср
+func RRel(hRel, lRel float64) float64 {
+	return 1 + (2*hRel-1)*lRel
+}
@@ -0,0 +1,175 @@
+package profiling
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"sort"
+)
+
+const (
+	StatorKind = "stator"
+	RotorKind  = "rotor"
+)
+
+const checkPointNum = 20 // число участков по высоте, на которых проверяются законы закрутки
+
+// Table - зависимость величины от относительной высоты лопатки (линейная интерполяция,
+// за пределами таблицы - крайние значения)
+type Table struct {
+	HRel   []float64 `json:"h_rel"`
+	Values []float64 `json:"values"`
+}
+
+func ConstTable(value float64) Table {
+	return Table{HRel: []float64{0, 1}, Values: []float64{value, value}}
+}
+
+func (t Table) Validate() error {
+	if len(t.HRel) == 0 || len(t.HRel) != len(t.Values) {
+		return fmt.Errorf("table must have equal nonzero number of h_rel and values, got %d and %d", len(t.HRel), len(t.Values))
+	}
+	if !sort.Float64sAreSorted(t.HRel) {
+		return fmt.Errorf("table h_rel must be sorted")
+	}
+	return nil
+}
+
+func (t Table) At(hRel float64) float64 {
+	return interpTable(t.HRel, t.Values, hRel)
+}
+
+// Radians возвращает функцию высоты для таблицы, заданной в градусах
+func (t Table) Radians() func(hRel float64) float64 {
+	return func(hRel float64) float64 {
+		return t.At(hRel) * math.Pi / 180
+	}
+}
+
+// ProfilerSpec - описание профилирования венца. Все углы задаются в градусах.
+type ProfilerSpec struct {
+	Kind       string  `json:"kind"`
+	Windage    float64 `json:"windage"`
+	ApproxTRel float64 `json:"approx_t_rel"`
+
+	InletLaw  LawSpec `json:"inlet_law"`
+	OutletLaw LawSpec `json:"outlet_law"`
+
+	InletAngleShift       Table `json:"inlet_angle_shift"`  // добавка к углу потока на входе
+	OutletAngleShift      Table `json:"outlet_angle_shift"` // добавка к углу потока на выходе
+	InstallationAngle     Table `json:"installation_angle"`
+	InletExpansionAngle   Table `json:"inlet_expansion_angle"`
+	OutletExpansionAngle  Table `json:"outlet_expansion_angle"`
+	InletPSAngleFraction  Table `json:"inlet_ps_angle_fraction"`
+	OutletPSAngleFraction Table `json:"outlet_ps_angle_fraction"`
+}
+
+func LoadProfilerSpec(path string) (ProfilerSpec, error) {
+	var data, err = ioutil.ReadFile(path)
+	if err != nil {
+		return ProfilerSpec{}, err
+	}
+	var spec ProfilerSpec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return ProfilerSpec{}, fmt.Errorf("failed to parse profiler spec %s: %v", path, err)
+	}
+	if err := spec.Validate(); err != nil {
+		return ProfilerSpec{}, fmt.Errorf("invalid profiler spec %s: %v", path, err)
+	}
+	return spec, nil
+}
+
+func (spec ProfilerSpec) Validate() error {
+	if spec.Kind != StatorKind && spec.Kind != RotorKind {
+		return fmt.Errorf("unknown blading kind \"%s\"", spec.Kind)
+	}
+	if err := spec.InletLaw.Validate(false); err != nil {
+		return fmt.Errorf("inlet law: %v", err)
+	}
+	if err := spec.OutletLaw.Validate(true); err != nil {
+		return fmt.Errorf("outlet law: %v", err)
+	}
+	for name, table := range spec.tables() {
+		if err := table.Validate(); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// Check проверяет, что законы закрутки дают положительную осевую скорость по всей высоте
+// при заданных скоростях на среднем радиусе на входе и выходе и относительной длине лопатки l / D_ср
+func (spec ProfilerSpec) Check(inlet, outlet MeanVelocity, lRel float64) error {
+	for _, item := range []struct {
+		name string
+		law  LawSpec
+		mean MeanVelocity
+	}{
+		{"inlet law", spec.InletLaw, inlet},
+		{"outlet law", spec.OutletLaw, outlet},
+	} {
+		if item.law.Name == ConstantAbsoluteAngle || item.law.Name == ConstantLabourLaw {
+			continue
+		}
+		for i := 0; i <= checkPointNum; i++ {
+			var x = RRel(float64(i)/checkPointNum, lRel)
+			if _, _, err := VortexComponents(item.law, item.mean.CU, item.mean.CA, item.mean.U, x); err != nil {
+				return fmt.Errorf("%s: %v", item.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (spec ProfilerSpec) tables() map[string]Table {
+	return map[string]Table{
+		"inlet_angle_shift":        spec.InletAngleShift,
+		"outlet_angle_shift":       spec.OutletAngleShift,
+		"installation_angle":       spec.InstallationAngle,
+		"inlet_expansion_angle":    spec.InletExpansionAngle,
+		"outlet_expansion_angle":   spec.OutletExpansionAngle,
+		"inlet_ps_angle_fraction":  spec.InletPSAngleFraction,
+		"outlet_ps_angle_fraction": spec.OutletPSAngleFraction,
+	}
+}
+
+func DefaultStatorSpec() ProfilerSpec {
+	return ProfilerSpec{
+		Kind:       StatorKind,
+		Windage:    1,
+		ApproxTRel: 0.7,
+
+		InletLaw:  LawSpec{Name: ConstantAbsoluteAngle},
+		OutletLaw: LawSpec{Name: ConstantAbsoluteAngle},
+
+		InletAngleShift:      ConstTable(0),
+		OutletAngleShift:     ConstTable(0),
+		InstallationAngle:    ConstTable(50),
+		InletExpansionAngle:  Table{HRel: []float64{0, 1}, Values: []float64{15, 30}},
+		OutletExpansionAngle: ConstTable(5),
+		InletPSAngleFraction: ConstTable(0.5),
+		// в исходной настройке доля задавалась выражением 1 / 3 в целочисленной арифметике
+		OutletPSAngleFraction: ConstTable(0),
+	}
+}
+
+func DefaultRotorSpec() ProfilerSpec {
+	return ProfilerSpec{
+		Kind:       RotorKind,
+		Windage:    1,
+		ApproxTRel: 0.7,
+
+		InletLaw:  LawSpec{Name: ConstantAbsoluteAngle},
+		OutletLaw: LawSpec{Name: ConstantLabourLaw},
+
+		InletAngleShift:      ConstTable(2),
+		OutletAngleShift:     ConstTable(0),
+		InstallationAngle:    Table{HRel: []float64{0, 0.5, 1}, Values: []float64{68, 55, 50}},
+		InletExpansionAngle:  Table{HRel: []float64{0, 1}, Values: []float64{20, 15}},
+		OutletExpansionAngle: ConstTable(5),
+		InletPSAngleFraction: Table{HRel: []float64{0, 1}, Values: []float64{0.7, 0.9}},
+		// в исходной настройке доля на втулке задавалась выражением 1 / 3 в целочисленной арифметике
+		OutletPSAngleFraction: Table{HRel: []float64{0, 1}, Values: []float64{0, 1}},
+	}
+}
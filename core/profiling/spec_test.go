@@ -0,0 +1,119 @@
+package profiling
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTable_At(t *testing.T) {
+	var table = Table{HRel: []float64{0, 0.5, 1}, Values: []float64{68, 55, 50}}
+	assert.InDelta(t, 68, table.At(-1), 1e-12)
+	assert.InDelta(t, 61.5, table.At(0.25), 1e-12)
+	assert.InDelta(t, 50, table.At(2), 1e-12)
+	assert.InDelta(t, 55*math.Pi/180, table.Radians()(0.5), 1e-12)
+
+	assert.Error(t, Table{HRel: []float64{0, 1}, Values: []float64{1}}.Validate())
+	assert.Error(t, Table{HRel: []float64{1, 0}, Values: []float64{1, 2}}.Validate())
+}
+
+func TestLoadProfilerSpec(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "spec")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var spec = DefaultRotorSpec()
+	spec.InletLaw = LawSpec{Name: ForcedVortexLaw, Exponent: 0.5}
+	data, err := json.Marshal(spec)
+	assert.NoError(t, err)
+	var path = filepath.Join(dir, "rotor.json")
+	assert.NoError(t, ioutil.WriteFile(path, data, 0644))
+
+	loaded, err := LoadProfilerSpec(path)
+	assert.NoError(t, err)
+	assert.Equal(t, spec.InletLaw, loaded.InletLaw)
+	assert.Equal(t, spec.InstallationAngle, loaded.InstallationAngle)
+
+	spec.InletLaw = LawSpec{Name: ConstantLabourLaw}
+	assert.Error(t, spec.Validate())
+	spec.InletLaw = LawSpec{Name: "solid"}
+	assert.Error(t, spec.Validate())
+}
+
+func TestVortexComponents(t *testing.T) {
+	var cuM, caM, uM = 200., 150., 300.
+
+	cu, ca, err := VortexComponents(LawSpec{Name: FreeVortexLaw}, cuM, caM, uM, 1.2)
+	assert.NoError(t, err)
+	assert.InDelta(t, cuM/1.2, cu, 1e-9)
+	assert.InDelta(t, caM, ca, 1e-9)
+
+	// закон c_u r^n с n = -1 совпадает со свободным вихрем
+	cu, ca, err = VortexComponents(LawSpec{Name: ForcedVortexLaw, Exponent: -1}, cuM, caM, uM, 1.2)
+	assert.NoError(t, err)
+	assert.InDelta(t, cuM/1.2, cu, 1e-9)
+	assert.InDelta(t, caM, ca, 1e-9)
+
+	// на среднем радиусе все законы дают треугольник среднего радиуса
+	for _, law := range []LawSpec{
+		{Name: ForcedVortexLaw, Exponent: 1},
+		{Name: ConstantReactivityLaw, Reactivity: 0.5},
+	} {
+		cu, ca, err = VortexComponents(law, cuM, caM, uM, 1)
+		assert.NoError(t, err)
+		assert.InDelta(t, cuM, cu, 1e-9, law.Name)
+		assert.InDelta(t, caM, ca, 1e-9, law.Name)
+	}
+
+	_, _, err = VortexComponents(LawSpec{Name: ForcedVortexLaw, Exponent: 1}, cuM, caM, uM, 2)
+	assert.Error(t, err)
+
+	var mean = MeanVelocity{CU: cuM, CA: caM, U: uM}
+	var spec = DefaultStatorSpec()
+	assert.NoError(t, spec.Check(mean, mean, 0.5))
+	spec.OutletLaw = LawSpec{Name: ForcedVortexLaw, Exponent: 1}
+	assert.Error(t, spec.Check(mean, mean, 0.5))
+	assert.NoError(t, spec.Check(mean, MeanVelocity{CU: 20, CA: caM, U: uM}, 0.5))
+}
+
+// TestVortexComponents_RadialEquilibrium сравнивает осевую скорость вне среднего радиуса
+// с численным интегрированием уравнения радиального равновесия от среднего радиуса
+func TestVortexComponents_RadialEquilibrium(t *testing.T) {
+	const pointNum = 2001
+	var cuM, caM, uM = 200., 150., 300.
+	for _, law := range []LawSpec{
+		{Name: FreeVortexLaw},
+		{Name: ForcedVortexLaw, Exponent: 1},
+		{Name: ForcedVortexLaw, Exponent: 0.5},
+		{Name: ConstantReactivityLaw, Reactivity: 0.5},
+		{Name: ConstantReactivityLaw, Reactivity: 0.3},
+	} {
+		for _, xEnd := range []float64{0.85, 1.1} {
+			var x = make([]float64, pointNum)
+			var cu = make([]float64, pointNum)
+			for i := range x {
+				x[i] = lerp(1, xEnd, float64(i)/(pointNum-1))
+				cu[i], _, _ = VortexComponents(law, cuM, caM, uM, x[i])
+			}
+			var d = equilibriumIncrements(x, cu, make([]float64, pointNum))
+
+			var _, ca, err = VortexComponents(law, cuM, caM, uM, xEnd)
+			assert.NoError(t, err)
+			assert.InDelta(t, math.Sqrt(caM*caM+d[pointNum-1]), ca, 1e-3, "%s %v at x = %.2f", law.Name, law.Exponent, xEnd)
+		}
+	}
+}
+
+func TestLoadProfilerSpec_Defaults(t *testing.T) {
+	var stator, err = LoadProfilerSpec("../../config/profilers/stator.json")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultStatorSpec(), stator)
+
+	rotor, err := LoadProfilerSpec("../../config/profilers/rotor.json")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultRotorSpec(), rotor)
+}
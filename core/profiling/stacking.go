@@ -0,0 +1,112 @@
+package profiling
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	StackCG = "cg" // центр тяжести сечения
+	StackLE = "le" // входная кромка
+	StackTE = "te" // выходная кромка
+)
+
+// Section - плоское сечение лопатки на радиусе Z (точки замкнутого контура в координатах x, y)
+type Section struct {
+	Z      float64
+	Points [][]float64
+}
+
+// StackingConfig задает линию укладки сечений: опорная точка каждого сечения
+// располагается на прямой, проходящей через опорную точку корневого сечения.
+type StackingConfig struct {
+	Mode  string
+	Lean  float64 // наклон в окружном направлении, рад
+	Sweep float64 // саблевидность в осевом направлении, рад
+}
+
+// BladeGrid - структурированная сетка точек лопатки [сечение][точка][x, y, z]
+type BladeGrid [][][]float64
+
+func Stack(sections []Section, conf StackingConfig) (BladeGrid, error) {
+	if len(sections) < 2 {
+		return nil, fmt.Errorf("at least 2 sections required, got %d", len(sections))
+	}
+	var pointNum = len(sections[0].Points)
+	if pointNum < 3 {
+		return nil, fmt.Errorf("section must contain at least 3 points")
+	}
+
+	var refArr = make([][]float64, len(sections))
+	for i, section := range sections {
+		if len(section.Points) != pointNum {
+			return nil, fmt.Errorf(
+				"section %d has %d points while section 0 has %d", i, len(section.Points), pointNum,
+			)
+		}
+		var x, y, err = ReferencePoint(section.Points, conf.Mode)
+		if err != nil {
+			return nil, err
+		}
+		refArr[i] = []float64{x, y}
+	}
+
+	var z0 = sections[0].Z
+	var result = make(BladeGrid, len(sections))
+	for i, section := range sections {
+		var dz = section.Z - z0
+		var dx = refArr[0][0] + dz*math.Tan(conf.Sweep) - refArr[i][0]
+		var dy = refArr[0][1] + dz*math.Tan(conf.Lean) - refArr[i][1]
+
+		result[i] = make([][]float64, pointNum)
+		for j, p := range section.Points {
+			result[i][j] = []float64{p[0] + dx, p[1] + dy, section.Z}
+		}
+	}
+	return result, nil
+}
+
+func ReferencePoint(points [][]float64, mode string) (x, y float64, err error) {
+	switch mode {
+	case StackCG:
+		x, y = Centroid(points)
+	case StackLE:
+		x, y = extremePoint(points, -1)
+	case StackTE:
+		x, y = extremePoint(points, 1)
+	default:
+		err = fmt.Errorf("unknown stacking mode \"%s\"", mode)
+	}
+	return
+}
+
+// Centroid возвращает центр тяжести площади многоугольника
+func Centroid(points [][]float64) (x, y float64) {
+	var area = 0.
+	for i := range points {
+		var p, q = points[i], points[(i+1)%len(points)]
+		var cross = p[0]*q[1] - q[0]*p[1]
+		area += cross
+		x += (p[0] + q[0]) * cross
+		y += (p[1] + q[1]) * cross
+	}
+	if area == 0 {
+		for _, p := range points {
+			x += p[0] / float64(len(points))
+			y += p[1] / float64(len(points))
+		}
+		return
+	}
+	return x / (3 * area), y / (3 * area)
+}
+
+// extremePoint возвращает точку с минимальной (sign < 0) или максимальной (sign > 0) координатой x
+func extremePoint(points [][]float64, sign float64) (x, y float64) {
+	x, y = points[0][0], points[0][1]
+	for _, p := range points {
+		if sign*p[0] > sign*x {
+			x, y = p[0], p[1]
+		}
+	}
+	return
+}
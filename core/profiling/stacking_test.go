@@ -0,0 +1,134 @@
+package profiling
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"strings"
+	"testing"
+)
+
+func square(x0, y0, a, z float64) Section {
+	return Section{
+		Z: z,
+		Points: [][]float64{
+			{x0, y0}, {x0 + a, y0}, {x0 + a, y0 + a}, {x0, y0 + a},
+		},
+	}
+}
+
+func TestCentroid(t *testing.T) {
+	var x, y = Centroid(square(1, 2, 2, 0).Points)
+	assert.InDelta(t, 2, x, 1e-12)
+	assert.InDelta(t, 3, y, 1e-12)
+}
+
+func TestStack(t *testing.T) {
+	var sections = []Section{square(0, 0, 2, 0.3), square(1, 1, 2, 0.4)}
+
+	var grid, err = Stack(sections, StackingConfig{Mode: StackCG})
+	assert.NoError(t, err)
+	var x, y = Centroid(grid[1])
+	assert.InDelta(t, 1, x, 1e-12)
+	assert.InDelta(t, 1, y, 1e-12)
+	assert.InDelta(t, 0.4, grid[1][0][2], 1e-12)
+
+	grid, err = Stack(sections, StackingConfig{Mode: StackLE, Lean: math.Pi / 4})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, grid[1][0][0], 1e-12)
+	assert.InDelta(t, 0.1, grid[1][0][1], 1e-12)
+
+	_, err = Stack(sections, StackingConfig{Mode: "hub"})
+	assert.Error(t, err)
+	_, err = Stack(sections[:1], StackingConfig{Mode: StackCG})
+	assert.Error(t, err)
+}
+
+func TestWriters(t *testing.T) {
+	var grid, err = Stack(
+		[]Section{square(0, 0, 1, 0), square(0, 0, 1, 1), square(0, 0, 1, 2)},
+		StackingConfig{Mode: StackCG},
+	)
+	assert.NoError(t, err)
+
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteGridCSV(buf, grid))
+	assert.Equal(t, 12, strings.Count(buf.String(), "\n"))
+
+	buf.Reset()
+	assert.NoError(t, WriteSTL(buf, grid))
+	// 2 пояса по 8 граней и 2 торца по 2 грани
+	assert.Equal(t, 20, strings.Count(buf.String(), "endfacet"))
+
+	buf.Reset()
+	assert.NoError(t, WriteIGES(buf, grid))
+	var lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	for _, line := range lines {
+		assert.Equal(t, 80, len(line))
+	}
+	assert.Equal(t, byte('T'), lines[len(lines)-1][72])
+	assert.Contains(t, lines[len(lines)-1], "D      6")
+
+	var again = new(bytes.Buffer)
+	assert.NoError(t, WriteIGES(again, grid))
+	assert.Equal(t, buf.String(), again.String())
+}
+
+// crescent - серповидное сечение, не являющееся звездным относительно центра тяжести
+func crescent(z float64, clockwise bool) Section {
+	var n = 40
+	var points = make([][]float64, 0, 2*n)
+	for i := 0; i != n; i++ {
+		var phi = math.Pi * float64(i) / float64(n-1)
+		points = append(points, []float64{math.Cos(phi), math.Sin(phi)})
+	}
+	for i := n - 1; i >= 0; i-- {
+		var phi = math.Pi * float64(i) / float64(n-1)
+		points = append(points, []float64{0.8 * math.Cos(phi), 0.8*math.Sin(phi) + 0.1})
+	}
+	if clockwise {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+	return Section{Z: z, Points: points}
+}
+
+func TestTriangulate_Crescent(t *testing.T) {
+	var points = crescent(0, false).Points
+	var triangles = triangulate(points)
+	assert.Len(t, triangles, len(points)-2)
+
+	var area = 0.
+	for _, tr := range triangles {
+		var a = cross2(points[tr[0]], points[tr[1]], points[tr[2]]) / 2
+		assert.True(t, a > 0)
+		area += a
+	}
+	assert.InDelta(t, signedArea(points), area, 1e-12)
+}
+
+// TestGridFacets_ClosedManifold проверяет, что каждое ребро поверхности принадлежит ровно двум граням
+// и обходится ими в противоположных направлениях
+func TestGridFacets_ClosedManifold(t *testing.T) {
+	for _, clockwise := range []bool{false, true} {
+		var grid, err = Stack(
+			[]Section{crescent(0, clockwise), crescent(1, clockwise), crescent(2, clockwise)},
+			StackingConfig{Mode: StackCG},
+		)
+		assert.NoError(t, err)
+
+		var edges = make(map[[2]string]int)
+		for _, facet := range gridFacets(grid) {
+			for i := range facet {
+				var a, b = fmt.Sprint(facet[i]), fmt.Sprint(facet[(i+1)%3])
+				edges[[2]string{a, b}]++
+			}
+		}
+		for edge, n := range edges {
+			assert.Equal(t, 1, n, "edge %v", edge)
+			assert.Equal(t, 1, edges[[2]string{edge[1], edge[0]}], "edge %v", edge)
+		}
+	}
+}
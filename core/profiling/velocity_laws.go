@@ -0,0 +1,75 @@
+package profiling
+
+import (
+	"github.com/Sovianum/turbocycle/impl/stage/states"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/laws"
+	"math"
+)
+
+func NewVelocityLaw(spec LawSpec) laws.VelocityLaw {
+	if spec.Name == ConstantAbsoluteAngle {
+		return laws.NewConstantAbsoluteAngleLaw()
+	}
+	return &vortexLaw{spec: spec}
+}
+
+// vortexLaw строит треугольники скоростей по закону закрутки из VortexComponents.
+// При отрицательном квадрате осевой скорости сохраняется треугольник среднего радиуса,
+// поскольку интерфейс закона не позволяет вернуть ошибку; проверка выполняется в ProfilerSpec.Check.
+type vortexLaw struct {
+	spec LawSpec
+}
+
+func (law *vortexLaw) InletTriangle(meanTriangle states.VelocityTriangle, hRel, lRel float64) states.VelocityTriangle {
+	var u, c, alpha = law.triangleParams(meanTriangle, hRel, lRel)
+	return states.NewInletTriangle(u, c, alpha)
+}
+
+func (law *vortexLaw) OutletTriangle(meanTriangle states.VelocityTriangle, hRel, lRel float64) states.VelocityTriangle {
+	var u, c, alpha = law.triangleParams(meanTriangle, hRel, lRel)
+	return states.NewOutletTriangle(u, c, alpha)
+}
+
+func (law *vortexLaw) triangleParams(meanTriangle states.VelocityTriangle, hRel, lRel float64) (u, c, alpha float64) {
+	var x = RRel(hRel, lRel)
+	var mean = meanVelocity(meanTriangle)
+
+	var cu, ca, err = VortexComponents(law.spec, mean.CU, mean.CA, mean.U, x)
+	if err != nil {
+		return meanTriangle.U(), meanTriangle.C(), meanTriangle.Alpha()
+	}
+	return meanTriangle.U() * x, math.Hypot(ca, cu), math.Atan2(ca, cu)
+}
+
+func meanVelocity(triangle states.VelocityTriangle) MeanVelocity {
+	return MeanVelocity{
+		CU: triangle.C() * math.Cos(triangle.Alpha()),
+		CA: triangle.C() * math.Sin(triangle.Alpha()),
+		U:  triangle.U(),
+	}
+}
+
+// NewStationLaw возвращает закон закрутки, заданный решением уравнения радиального равновесия.
+// Окружная скорость берется из треугольника среднего радиуса с учетом относительной высоты.
+func NewStationLaw(station Station) laws.VelocityLaw {
+	return &stationLaw{station: station}
+}
+
+type stationLaw struct {
+	station Station
+}
+
+func (law *stationLaw) InletTriangle(meanTriangle states.VelocityTriangle, hRel, lRel float64) states.VelocityTriangle {
+	var u, c, alpha = law.triangleParams(meanTriangle, hRel, lRel)
+	return states.NewInletTriangle(u, c, alpha)
+}
+
+func (law *stationLaw) OutletTriangle(meanTriangle states.VelocityTriangle, hRel, lRel float64) states.VelocityTriangle {
+	var u, c, alpha = law.triangleParams(meanTriangle, hRel, lRel)
+	return states.NewOutletTriangle(u, c, alpha)
+}
+
+func (law *stationLaw) triangleParams(meanTriangle states.VelocityTriangle, hRel, lRel float64) (u, c, alpha float64) {
+	var cu, ca = law.station.At(hRel)
+	return meanTriangle.U() * RRel(hRel, lRel), math.Hypot(ca, cu), math.Atan2(ca, cu)
+}
@@ -0,0 +1,308 @@
+package io
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ManifestName - имя файла манифеста в каталоге результатов
+const ManifestName = "manifest.json"
+
+const turbocyclePath = "github.com/Sovianum/turbocycle"
+
+// FileRecord - контрольная сумма файла, прочитанного или записанного расчетом
+type FileRecord struct {
+	Path     string    `json:"path"`
+	SHA256   string    `json:"sha256"`
+	Modified time.Time `json:"modified"`
+}
+
+// Manifest описывает, из каких исходных данных, какой версией кода и с какими настройками
+// получены файлы результатов
+type Manifest struct {
+	Revision          string                 `json:"revision"`
+	TurbocycleVersion string                 `json:"turbocycle_version"`
+	Started           time.Time              `json:"started"`
+	Finished          time.Time              `json:"finished"`
+	Settings          map[string]interface{} `json:"settings"`
+
+	SourceRoots []string     `json:"source_roots"`
+	InputRoots  []string     `json:"input_roots"`
+	OutputRoots []string     `json:"output_roots"`
+	Sources     []FileRecord `json:"sources"`
+	Inputs      []FileRecord `json:"inputs"`
+	Outputs     []FileRecord `json:"outputs"`
+}
+
+// StaleError - расхождение между манифестом и текущим состоянием файлов
+type StaleError struct {
+	Path   string
+	Reason string
+}
+
+func (e StaleError) Error() string {
+	return e.Path + ": " + e.Reason
+}
+
+// Run - запуск расчета, для которого составляется манифест
+type Run struct {
+	manifest Manifest
+}
+
+// NewRun запоминает время начала расчета и контрольные суммы исходного кода (файлов .go) из sourceRoots
+// и исходных данных из inputRoots. Коммит git не учитывает незакоммиченные правки кода,
+// поэтому код хешируется наравне с данными, а коммит сохраняется только для справки.
+// Результатами запуска считаются файлы outputRoots, записанные после вызова NewRun.
+func NewRun(settings map[string]interface{}, sourceRoots, inputRoots, outputRoots []string) (*Run, error) {
+	var inputs, err = hashFiles(inputRoots, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	sources, err := hashFiles(sourceRoots, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return &Run{manifest: Manifest{
+		Revision:          Revision("."),
+		TurbocycleVersion: TurbocycleVersion(),
+		Started:           time.Now(),
+		Settings:          settings,
+		SourceRoots:       sourceRoots,
+		InputRoots:        inputRoots,
+		OutputRoots:       outputRoots,
+		Sources:           goRecords(sources),
+		Inputs:            inputs,
+	}}, nil
+}
+
+// Finish собирает контрольные суммы результатов и возвращает манифест запуска
+func (r *Run) Finish() (Manifest, error) {
+	var m = r.manifest
+	// точность времени изменения файла в некоторых файловых системах - одна секунда
+	var outputs, err = hashFiles(m.OutputRoots, m.Started.Truncate(time.Second))
+	if err != nil {
+		return Manifest{}, err
+	}
+	m.Outputs = outputs
+	m.Finished = time.Now()
+	return m, nil
+}
+
+func LoadManifest(path string) (Manifest, error) {
+	var m Manifest
+	var b, err = ioutil.ReadFile(path)
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("failed to parse manifest %s: %v", path, err)
+	}
+	return m, nil
+}
+
+func (m Manifest) Save(path string) error {
+	var b, err = json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+// Verify сравнивает манифест с текущими файлами и настройками settings. Изменение исходных данных,
+// настроек или исходного кода означает, что все результаты устарели; измененные и удаленные
+// результаты перечисляются отдельно. Коммит сравнивается только для манифестов без контрольных сумм кода.
+func (m Manifest) Verify(settings map[string]interface{}) []error {
+	var result []error
+	var revision = Revision(".")
+	if len(m.Sources) == 0 && m.Revision != "" && revision != "" && revision != m.Revision {
+		result = append(result, StaleError{
+			Path:   ".",
+			Reason: fmt.Sprintf("code revision changed from %s to %s", m.Revision, revision),
+		})
+	}
+	result = append(result, m.compareSettings(settings)...)
+
+	var inputs, err = hashFiles(m.InputRoots, time.Time{})
+	if err != nil {
+		return append(result, err)
+	}
+	sources, err := hashFiles(m.SourceRoots, time.Time{})
+	if err != nil {
+		return append(result, err)
+	}
+	result = append(result, compareRecords(m.Sources, goRecords(sources), "source")...)
+	result = append(result, compareRecords(m.Inputs, inputs, "input")...)
+
+	for _, record := range m.Outputs {
+		var sum, err = hashFile(record.Path)
+		switch {
+		case os.IsNotExist(err):
+			result = append(result, StaleError{Path: record.Path, Reason: "output is missing"})
+		case err != nil:
+			result = append(result, err)
+		case sum != record.SHA256:
+			result = append(result, StaleError{Path: record.Path, Reason: "output was modified after the run"})
+		}
+	}
+	return result
+}
+
+// compareSettings сравнивает настройки по строковому представлению: после чтения манифеста
+// все числа имеют тип float64
+func (m Manifest) compareSettings(settings map[string]interface{}) []error {
+	var names = make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result []error
+	for _, name := range names {
+		var recorded, ok = m.Settings[name]
+		var current = fmt.Sprint(settings[name])
+		if !ok || fmt.Sprint(recorded) != current {
+			result = append(result, StaleError{
+				Path:   "settings",
+				Reason: fmt.Sprintf("%s changed from %v to %s", name, recorded, current),
+			})
+		}
+	}
+	return result
+}
+
+func compareRecords(recorded, current []FileRecord, kind string) []error {
+	var currentSums = make(map[string]string, len(current))
+	for _, record := range current {
+		currentSums[record.Path] = record.SHA256
+	}
+
+	var result []error
+	for _, record := range recorded {
+		var sum, ok = currentSums[record.Path]
+		switch {
+		case !ok:
+			result = append(result, StaleError{Path: record.Path, Reason: kind + " was removed"})
+		case sum != record.SHA256:
+			result = append(result, StaleError{Path: record.Path, Reason: kind + " changed"})
+		}
+		delete(currentSums, record.Path)
+	}
+
+	var added = make([]string, 0, len(currentSums))
+	for path := range currentSums {
+		added = append(added, path)
+	}
+	sort.Strings(added)
+	for _, path := range added {
+		result = append(result, StaleError{Path: path, Reason: "new " + kind})
+	}
+	return result
+}
+
+// goRecords оставляет только исходный код: в каталогах кода лежат также шаблоны, изображения
+// и блокноты (postprocessing), которые учитываются как исходные данные либо не учитываются вовсе
+func goRecords(records []FileRecord) []FileRecord {
+	var result = make([]FileRecord, 0, len(records))
+	for _, record := range records {
+		if filepath.Ext(record.Path) == ".go" {
+			result = append(result, record)
+		}
+	}
+	return result
+}
+
+// hashFiles возвращает контрольные суммы файлов каталогов roots, измененных не раньше since.
+// Файл, попавший в несколько каталогов, учитывается один раз; манифест не учитывается.
+func hashFiles(roots []string, since time.Time) ([]FileRecord, error) {
+	var result []FileRecord
+	var seen = make(map[string]bool)
+	for _, root := range roots {
+		var err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || info.Name() == ManifestName || info.ModTime().Before(since) {
+				return nil
+			}
+			var abs, absErr = filepath.Abs(path)
+			if absErr != nil {
+				return absErr
+			}
+			if seen[abs] {
+				return nil
+			}
+			seen[abs] = true
+
+			var sum, hashErr = hashFile(path)
+			if hashErr != nil {
+				return hashErr
+			}
+			result = append(result, FileRecord{Path: filepath.Clean(path), SHA256: sum, Modified: info.ModTime()})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
+
+func hashFile(path string) (string, error) {
+	var file, err = os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	var h = sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Revision возвращает коммит git, в котором находится каталог dir, либо пустую строку
+func Revision(dir string) string {
+	var out, err = exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// TurbocycleVersion возвращает версию модуля turbocycle из сведений о сборке,
+// а при сборке в GOPATH - коммит его исходников
+func TurbocycleVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == turbocyclePath {
+				return dep.Version
+			}
+		}
+	}
+	for _, gopath := range filepath.SplitList(build.Default.GOPATH) {
+		var dir = filepath.Join(gopath, "src", turbocyclePath)
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err != nil {
+			continue
+		}
+		if revision := Revision(dir); revision != "" {
+			return revision
+		}
+	}
+	return "unknown"
+}
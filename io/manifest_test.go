@@ -0,0 +1,117 @@
+package io
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+func TestManifest_Verify(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceDir, inputDir, outputDir = filepath.Join(dir, "core"), filepath.Join(dir, "config"), filepath.Join(dir, "build")
+	PrepareDirectories(sourceDir, inputDir, outputDir)
+	writeFile(t, filepath.Join(sourceDir, "solver.go"), "package core")
+	writeFile(t, filepath.Join(inputDir, "stator.json"), `{"n": 1}`)
+
+	var settings = map[string]interface{}{"odeTolerance": 1e-6, "iterNum": 100}
+	run, err := NewRun(settings, []string{sourceDir}, []string{inputDir}, []string{outputDir})
+	require.NoError(t, err)
+	writeFile(t, filepath.Join(outputDir, "a.csv"), "1;2")
+	writeFile(t, filepath.Join(outputDir, "b.tex"), "text")
+
+	m, err := run.Finish()
+	require.NoError(t, err)
+	require.Len(t, m.Sources, 1)
+	require.Len(t, m.Inputs, 1)
+	require.Len(t, m.Outputs, 2)
+	assert.Equal(t, filepath.Join(outputDir, "a.csv"), m.Outputs[0].Path)
+	assert.False(t, m.Finished.Before(m.Started))
+
+	var manifestPath = filepath.Join(outputDir, ManifestName)
+	require.NoError(t, m.Save(manifestPath))
+	loaded, err := LoadManifest(manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1e-6, loaded.Settings["odeTolerance"])
+	assert.Empty(t, loaded.Verify(settings))
+
+	// при сохраненных контрольных суммах кода смена коммита не делает результаты устаревшими
+	loaded.Revision = "0000000"
+	assert.Empty(t, loaded.Verify(settings))
+
+	// незакоммиченная правка кода не меняет коммит, но меняет контрольную сумму
+	writeFile(t, filepath.Join(sourceDir, "solver.go"), "package core\n")
+	writeFile(t, filepath.Join(inputDir, "stator.json"), `{"n": 2}`)
+	writeFile(t, filepath.Join(inputDir, "rotor.json"), `{}`)
+	writeFile(t, filepath.Join(outputDir, "a.csv"), "1;3")
+	require.NoError(t, os.Remove(filepath.Join(outputDir, "b.tex")))
+
+	settings["odeTolerance"] = 1e-7
+
+	var errs = loaded.Verify(settings)
+	require.Len(t, errs, 6)
+	assert.Equal(t, "settings: odeTolerance changed from 1e-06 to 1e-07", errs[0].Error())
+	assert.Equal(t, filepath.Join(sourceDir, "solver.go")+": source changed", errs[1].Error())
+	assert.Equal(t, filepath.Join(inputDir, "stator.json")+": input changed", errs[2].Error())
+	assert.Equal(t, filepath.Join(inputDir, "rotor.json")+": new input", errs[3].Error())
+	assert.Equal(t, filepath.Join(outputDir, "a.csv")+": output was modified after the run", errs[4].Error())
+	assert.Equal(t, filepath.Join(outputDir, "b.tex")+": output is missing", errs[5].Error())
+}
+
+func TestManifest_RevisionWithoutSources(t *testing.T) {
+	if Revision(".") == "" {
+		t.Skip("not a git repository")
+	}
+	var m = Manifest{Revision: "0000000"}
+	var errs = m.Verify(nil)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), "code revision changed")
+}
+
+func TestManifest_InputsInsideSources(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var sourceDir = filepath.Join(dir, "postprocessing")
+	var inputDir = filepath.Join(sourceDir, "templates")
+	var mediaDir = filepath.Join(sourceDir, "media")
+	PrepareDirectories(inputDir, mediaDir)
+	writeFile(t, filepath.Join(sourceDir, "report.go"), "package postprocessing")
+	writeFile(t, filepath.Join(inputDir, "root.tex"), "text")
+	writeFile(t, filepath.Join(mediaDir, "scheme.svg"), "<svg/>")
+
+	run, err := NewRun(nil, []string{sourceDir}, []string{inputDir}, nil)
+	require.NoError(t, err)
+	m, err := run.Finish()
+	require.NoError(t, err)
+	require.Len(t, m.Sources, 1)
+	assert.Equal(t, filepath.Join(sourceDir, "report.go"), m.Sources[0].Path)
+	require.Len(t, m.Inputs, 1)
+
+	writeFile(t, filepath.Join(inputDir, "root.tex"), "changed")
+	writeFile(t, filepath.Join(mediaDir, "scheme.svg"), "<svg></svg>")
+	writeFile(t, filepath.Join(sourceDir, "analysis.ipynb"), "{}")
+	var errs = m.Verify(nil)
+	require.Len(t, errs, 1)
+	assert.Equal(t, filepath.Join(inputDir, "root.tex")+": input changed", errs[0].Error())
+}
+
+func TestNewRun_MissingInput(t *testing.T) {
+	var _, err = NewRun(nil, nil, []string{"no-such-dir"}, nil)
+	assert.Error(t, err)
+}
+
+func TestNewRun_MissingSource(t *testing.T) {
+	var _, err = NewRun(nil, []string{"no-such-dir"}, nil, nil)
+	assert.Error(t, err)
+}
@@ -1,9 +1,84 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
 	"github.com/Sovianum/cooling-course-project/scripts/diploma"
+	"os"
 )
 
+const (
+	checkTemplatesCmd = "check-templates"
+	verifyCmd         = "verify"
+)
+
+const langUsage = "language of generated reports (ru, en)"
+
+var lang = flag.String("lang", string(locale.Russian), langUsage)
+
 func main() {
-	diploma.Entry()
+	flag.Parse()
+	var cmd = flag.Arg(0)
+	switch cmd {
+	case checkTemplatesCmd, verifyCmd:
+		parseCommandFlags(cmd, flag.Args()[1:])
+	case "":
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", cmd)
+		os.Exit(2)
+	}
+
+	var reportLang, err = locale.Parse(*lang)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	locale.Set(reportLang)
+
+	switch cmd {
+	case checkTemplatesCmd:
+		checkTemplates()
+	case verifyCmd:
+		verify()
+	default:
+		diploma.Entry()
+	}
+}
+
+// parseCommandFlags разбирает флаги, указанные после подкоманды; они переопределяют флаги перед ней
+func parseCommandFlags(cmd string, args []string) {
+	var flags = flag.NewFlagSet(cmd, flag.ExitOnError)
+	flags.StringVar(lang, "lang", *lang, langUsage)
+	flags.Parse(args)
+	if flags.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "unexpected arguments of %s: %v\n", cmd, flags.Args())
+		os.Exit(2)
+	}
+}
+
+func checkTemplates() {
+	var errs = diploma.CheckTemplates()
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	if len(errs) > 0 {
+		os.Exit(1)
+	}
+	fmt.Println("templates ok")
+}
+
+func verify() {
+	var errs, err = diploma.VerifyOutputs()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	if len(errs) > 0 {
+		os.Exit(1)
+	}
+	fmt.Println("outputs up to date")
 }
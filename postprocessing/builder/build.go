@@ -2,38 +2,163 @@ package builder
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 )
 
+const (
+	defaultMaxPasses = 4
+	biber            = "biber"
+)
+
+type Config struct {
+	RootDir  string
+	RootFile string
+
+	Engine      string // пусто - автоматический выбор
+	DockerImage string // образ, используемый при отсутствии локального движка
+	MaxPasses   int    // наибольшее число проходов движка, 0 - defaultMaxPasses
+
+	Output io.Writer // вывод движка, nil - os.Stdout
+}
+
+// BuildError перечисляет ошибки, найденные в .log файле после сборки
+type BuildError struct {
+	Engine Engine
+	Report LogReport
+	Err    error // ошибка запуска движка, если была
+}
+
+func (e *BuildError) Error() string {
+	var lines = []string{fmt.Sprintf("latex build with %s failed", e.Engine)}
+	if e.Err != nil {
+		lines = append(lines, e.Err.Error())
+	}
+	for _, entry := range e.Report.Errors {
+		lines = append(lines, "error: "+entry.String())
+	}
+	for _, entry := range e.Report.UndefinedReferences {
+		lines = append(lines, "undefined reference: "+entry.String())
+	}
+	for _, entry := range e.Report.UndefinedCitations {
+		lines = append(lines, "undefined citation: "+entry.String())
+	}
+	for _, box := range e.Report.OverfullBoxes {
+		lines = append(lines, "overfull box: "+box.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
 func BuildLatex(rootDir, rootFileName string) error {
-	fmt.Println("Build latex")
+	_, err := Build(Config{RootDir: rootDir, RootFile: rootFileName})
+	return err
+}
+
+// Build собирает документ, повторяя проходы движка (и biber при наличии .bcf файла), пока TeX
+// требует повторного запуска. Возвращает отчет по .log файлу последнего прохода.
+func Build(conf Config) (LogReport, error) {
+	var engine, err = DetectEngine(conf.Engine, conf.DockerImage)
+	if err != nil {
+		return LogReport{}, err
+	}
+	if conf.Output == nil {
+		conf.Output = os.Stdout
+	}
+	var b = latexBuilder{conf: conf, engine: engine, run: commandRunner(conf.Output)}
+	return b.build()
+}
 
-	cleanupCmd := getCmd(getCleanupStr())
-	if err := cleanupCmd.Run(); err != nil {
-		return err
+type runFunc func(dir, name string, args ...string) error
+
+func commandRunner(output io.Writer) runFunc {
+	return func(dir, name string, args ...string) error {
+		var cmd = exec.Command(name, args...)
+		cmd.Dir = dir
+		cmd.Stdout = output
+		cmd.Stderr = output
+		return cmd.Run()
+	}
+}
+
+type latexBuilder struct {
+	conf   Config
+	engine Engine
+	run    runFunc
+}
+
+func (b latexBuilder) build() (LogReport, error) {
+	fmt.Fprintf(b.conf.Output, "Build latex with %s\n", b.engine)
+
+	var maxPasses = b.conf.MaxPasses
+	if maxPasses <= 0 {
+		maxPasses = defaultMaxPasses
+	}
+
+	var report LogReport
+	var runErr error
+	for pass := 0; pass != maxPasses; pass++ {
+		runErr = b.runEngine()
+
+		var err error
+		if report, err = b.readLog(); err != nil {
+			return LogReport{}, err
+		}
+		if runErr != nil || len(report.Errors) > 0 || b.engine.Name == Latexmk {
+			// latexmk сам выполняет нужное число проходов
+			break
+		}
+
+		if pass == 0 && b.fileExists(".bcf") {
+			if err := b.runTool(biber, b.baseName()); err != nil {
+				return report, &BuildError{Engine: b.engine, Report: report, Err: fmt.Errorf("biber: %v", err)}
+			}
+			continue
+		}
+		if !report.NeedsRerun {
+			break
+		}
+	}
+
+	if runErr != nil || report.Failed() {
+		return report, &BuildError{Engine: b.engine, Report: report, Err: runErr}
+	}
+	return report, nil
+}
+
+func (b latexBuilder) runEngine() error {
+	var args = []string{"-shell-escape", "-synctex=1", "-interaction=nonstopmode"}
+	if b.engine.Name == Latexmk {
+		args = append([]string{"-pdf"}, args...)
 	}
+	return b.runTool(b.engine.Name, append(args, b.conf.RootFile)...)
+}
 
-	runCmd := getCmd(getBuildStr(rootDir, rootFileName))
-	runCmd.Run()
-	return nil
+func (b latexBuilder) runTool(program string, args ...string) error {
+	var name, fullArgs = b.engine.command(b.conf.RootDir, program, args...)
+	return b.run(b.conf.RootDir, name, fullArgs...)
+}
+
+func (b latexBuilder) readLog() (LogReport, error) {
+	var file, err = os.Open(b.path(".log"))
+	if err != nil {
+		return LogReport{}, fmt.Errorf("failed to read latex log: %v", err)
+	}
+	defer file.Close()
+	return ParseLog(file)
 }
 
-func getCmd(text string) *exec.Cmd {
-	cmd := exec.Command("bash", "-c", text)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stdout
-	return cmd
+func (b latexBuilder) fileExists(ext string) bool {
+	var _, err = os.Stat(b.path(ext))
+	return err == nil
 }
 
-func getCleanupStr() string {
-	return "docker kill latex_container || true && docker rm latex_container || true"
+func (b latexBuilder) path(ext string) string {
+	return filepath.Join(b.conf.RootDir, b.baseName()+ext)
 }
 
-func getBuildStr(buildDir, rootFileName string) string {
-	return fmt.Sprintf(
-		"docker run -it -d --name latex_container -v %s:/home -w /home sumdoc/texlive-2017 /bin/bash && \\"+
-			"docker exec $(docker ps -aqf 'name=latex_container') pdflatex -shell-escape -syntex=1 -interaction=nonstopmode /home/%s",
-		buildDir, rootFileName,
-	)
+func (b latexBuilder) baseName() string {
+	return strings.TrimSuffix(b.conf.RootFile, filepath.Ext(b.conf.RootFile))
 }
@@ -0,0 +1,87 @@
+package builder
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+const (
+	PDFLatex = "pdflatex"
+	XeLatex  = "xelatex"
+	LuaLatex = "lualatex"
+	Latexmk  = "latexmk"
+
+	docker             = "docker"
+	defaultDockerImage = "sumdoc/texlive-2017"
+)
+
+// порядок, в котором ищутся локально установленные движки
+var enginePriority = []string{PDFLatex, XeLatex, LuaLatex, Latexmk}
+
+// Engine - способ запуска TeX: локальный исполняемый файл или он же внутри docker-образа
+type Engine struct {
+	Name        string
+	DockerImage string // пусто - локальный запуск
+}
+
+func (e Engine) Docker() bool {
+	return e.DockerImage != ""
+}
+
+func (e Engine) String() string {
+	if e.Docker() {
+		return fmt.Sprintf("%s (docker %s)", e.Name, e.DockerImage)
+	}
+	return e.Name
+}
+
+// DetectEngine возвращает движок name (или первый найденный из enginePriority, если name пусто).
+// Если локально движок не найден, используется pdflatex в docker-образе dockerImage.
+func DetectEngine(name, dockerImage string) (Engine, error) {
+	return detectEngine(name, dockerImage, exec.LookPath)
+}
+
+func detectEngine(name, dockerImage string, lookPath func(string) (string, error)) (Engine, error) {
+	var candidates = enginePriority
+	if name != "" {
+		if !isKnownEngine(name) {
+			return Engine{}, fmt.Errorf("unknown tex engine %q", name)
+		}
+		candidates = []string{name}
+	}
+	for _, candidate := range candidates {
+		if _, err := lookPath(candidate); err == nil {
+			return Engine{Name: candidate}, nil
+		}
+	}
+
+	if _, err := lookPath(docker); err != nil {
+		return Engine{}, fmt.Errorf("no tex engine among %v and no docker found", candidates)
+	}
+	if dockerImage == "" {
+		dockerImage = defaultDockerImage
+	}
+	var dockerEngine = PDFLatex
+	if name != "" {
+		dockerEngine = name
+	}
+	return Engine{Name: dockerEngine, DockerImage: dockerImage}, nil
+}
+
+func isKnownEngine(name string) bool {
+	for _, engine := range enginePriority {
+		if engine == name {
+			return true
+		}
+	}
+	return false
+}
+
+// command возвращает команду запуска program с аргументами args в каталоге dir с учетом docker
+func (e Engine) command(dir, program string, args ...string) (string, []string) {
+	if !e.Docker() {
+		return program, args
+	}
+	var dockerArgs = []string{"run", "--rm", "-v", dir + ":/home", "-w", "/home", e.DockerImage, program}
+	return docker, append(dockerArgs, args...)
+}
@@ -0,0 +1,128 @@
+package builder
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func lookPathOf(available ...string) func(string) (string, error) {
+	return func(name string) (string, error) {
+		for _, a := range available {
+			if a == name {
+				return "/usr/bin/" + name, nil
+			}
+		}
+		return "", fmt.Errorf("%s not found", name)
+	}
+}
+
+func TestDetectEngine(t *testing.T) {
+	var engine, err = detectEngine("", "", lookPathOf(LuaLatex, XeLatex))
+	assert.Nil(t, err)
+	assert.Equal(t, Engine{Name: XeLatex}, engine)
+
+	engine, err = detectEngine(LuaLatex, "", lookPathOf(LuaLatex, XeLatex))
+	assert.Nil(t, err)
+	assert.Equal(t, Engine{Name: LuaLatex}, engine)
+
+	engine, err = detectEngine("", "", lookPathOf(docker))
+	assert.Nil(t, err)
+	assert.Equal(t, Engine{Name: PDFLatex, DockerImage: defaultDockerImage}, engine)
+
+	engine, err = detectEngine(XeLatex, "texlive/texlive", lookPathOf(docker, PDFLatex))
+	assert.Nil(t, err)
+	assert.Equal(t, Engine{Name: XeLatex, DockerImage: "texlive/texlive"}, engine)
+
+	_, err = detectEngine("", "", lookPathOf())
+	assert.Error(t, err)
+	_, err = detectEngine("tectonic", "", lookPathOf(docker))
+	assert.Error(t, err)
+}
+
+func TestEngine_Command(t *testing.T) {
+	var name, args = Engine{Name: PDFLatex}.command("/build", PDFLatex, "root.tex")
+	assert.Equal(t, PDFLatex, name)
+	assert.Equal(t, []string{"root.tex"}, args)
+
+	name, args = Engine{Name: PDFLatex, DockerImage: "img"}.command("/build", biber, "root")
+	assert.Equal(t, docker, name)
+	assert.Equal(t, []string{"run", "--rm", "-v", "/build:/home", "-w", "/home", "img", biber, "root"}, args)
+}
+
+type fakeTex struct {
+	dir   string
+	logs  []string // содержимое .log после очередного прохода
+	calls []string
+	bcf   bool
+}
+
+func (f *fakeTex) run(dir, name string, args ...string) error {
+	f.calls = append(f.calls, name)
+	if name == biber {
+		return nil
+	}
+	var log = f.logs[0]
+	if len(f.logs) > 1 {
+		f.logs = f.logs[1:]
+	}
+	if f.bcf {
+		if err := ioutil.WriteFile(filepath.Join(dir, "root.bcf"), nil, 0644); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "root.log"), []byte(log), 0644)
+}
+
+func newFakeBuilder(t *testing.T, tex *fakeTex, engine Engine) latexBuilder {
+	var dir, err = ioutil.TempDir("", "builder")
+	assert.Nil(t, err)
+	tex.dir = dir
+	return latexBuilder{
+		conf:   Config{RootDir: dir, RootFile: "root.tex", MaxPasses: 3, Output: ioutil.Discard},
+		engine: engine,
+		run:    tex.run,
+	}
+}
+
+func TestLatexBuilder_Passes(t *testing.T) {
+	var rerun = "LaTeX Warning: Label(s) may have changed. Rerun to get cross-references right.\n"
+	var clean = "Output written on root.pdf (1 page).\n"
+
+	var tex = &fakeTex{logs: []string{rerun, rerun, clean}, bcf: true}
+	var b = newFakeBuilder(t, tex, Engine{Name: PDFLatex})
+	defer os.RemoveAll(tex.dir)
+
+	var report, err = b.build()
+	assert.Nil(t, err)
+	assert.False(t, report.NeedsRerun)
+	assert.Equal(t, []string{PDFLatex, biber, PDFLatex, PDFLatex}, tex.calls)
+
+	// latexmk запускается один раз
+	tex = &fakeTex{logs: []string{rerun}}
+	b = newFakeBuilder(t, tex, Engine{Name: Latexmk})
+	defer os.RemoveAll(tex.dir)
+	_, err = b.build()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{Latexmk}, tex.calls)
+}
+
+func TestLatexBuilder_Errors(t *testing.T) {
+	var tex = &fakeTex{logs: []string{sampleLog}}
+	var b = newFakeBuilder(t, tex, Engine{Name: PDFLatex})
+	defer os.RemoveAll(tex.dir)
+
+	var report, err = b.build()
+	assert.Error(t, err)
+	assert.Equal(t, []string{PDFLatex}, tex.calls)
+
+	var buildErr, ok = err.(*BuildError)
+	assert.True(t, ok)
+	assert.Equal(t, report, buildErr.Report)
+	assert.Contains(t, err.Error(), "error: line 42: Undefined control sequence.")
+	assert.Contains(t, err.Error(), "undefined reference: line 57: fig:cycle")
+	assert.Contains(t, err.Error(), "overfull box: line 10")
+}
@@ -0,0 +1,113 @@
+package builder
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	errorLineRe    = regexp.MustCompile(`^l\.(\d+)`)
+	undefinedRefRe = regexp.MustCompile("(Reference|Citation) [`']([^']+)' on page \\d+ undefined(?: on input line (\\d+))?")
+	overfullRe     = regexp.MustCompile(`^(Overfull \\[hv]box \(([\d.]+)pt too (?:wide|high)\).*?)(?: at lines? (\d+))?(?:--\d+)?$`)
+	rerunRe        = regexp.MustCompile(`Rerun to get|Label\(s\) may have changed|Please \(re\)run Biber`)
+	fileLineWidth  = 79 // pdflatex переносит строки лога по этой ширине
+)
+
+type LogEntry struct {
+	Line    int // строка исходного файла, 0 - если неизвестна
+	Message string
+}
+
+func (e LogEntry) String() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+type OverfullBox struct {
+	LogEntry
+	Excess float64 // pt
+}
+
+// LogReport - разобранный .log файл TeX
+type LogReport struct {
+	Errors              []LogEntry
+	UndefinedReferences []LogEntry
+	UndefinedCitations  []LogEntry
+	OverfullBoxes       []OverfullBox
+	NeedsRerun          bool
+}
+
+func (r LogReport) Failed() bool {
+	return len(r.Errors) > 0 || len(r.UndefinedReferences) > 0 || len(r.UndefinedCitations) > 0
+}
+
+func ParseLog(reader io.Reader) (LogReport, error) {
+	var lines, err = readLogLines(reader)
+	if err != nil {
+		return LogReport{}, err
+	}
+
+	var result LogReport
+	for i := 0; i < len(lines); i++ {
+		var line = lines[i]
+		switch {
+		case strings.HasPrefix(line, "! "):
+			var entry = LogEntry{Message: strings.TrimPrefix(line, "! ")}
+			// номер строки выводится в контексте ошибки в виде "l.123 ..."
+			for j := i + 1; j < len(lines) && j <= i+10; j++ {
+				if m := errorLineRe.FindStringSubmatch(lines[j]); m != nil {
+					entry.Line, _ = strconv.Atoi(m[1])
+					break
+				}
+			}
+			result.Errors = append(result.Errors, entry)
+
+		case undefinedRefRe.MatchString(line):
+			var m = undefinedRefRe.FindStringSubmatch(line)
+			var entry = LogEntry{Message: m[2]}
+			entry.Line, _ = strconv.Atoi(m[3])
+			if m[1] == "Reference" {
+				result.UndefinedReferences = append(result.UndefinedReferences, entry)
+			} else {
+				result.UndefinedCitations = append(result.UndefinedCitations, entry)
+			}
+
+		case overfullRe.MatchString(line):
+			var m = overfullRe.FindStringSubmatch(line)
+			var box = OverfullBox{LogEntry: LogEntry{Message: m[1]}}
+			box.Excess, _ = strconv.ParseFloat(m[2], 64)
+			box.Line, _ = strconv.Atoi(m[3])
+			result.OverfullBoxes = append(result.OverfullBoxes, box)
+		}
+
+		if rerunRe.MatchString(line) {
+			result.NeedsRerun = true
+		}
+	}
+	return result, nil
+}
+
+// readLogLines склеивает строки, перенесенные TeX по ширине fileLineWidth
+func readLogLines(reader io.Reader) ([]string, error) {
+	var scanner = bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var result []string
+	var joinNext = false
+	for scanner.Scan() {
+		var line = scanner.Text()
+		if joinNext {
+			result[len(result)-1] += line
+		} else {
+			result = append(result, line)
+		}
+		joinNext = len(line) == fileLineWidth
+	}
+	return result, scanner.Err()
+}
@@ -0,0 +1,54 @@
+package builder
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const sampleLog = `This is pdfTeX, Version 3.14159265-2.6-1.40.18 (TeX Live 2017) (preloaded format=pdflatex)
+(./root.tex
+LaTeX2e <2017-04-15>
+! Undefined control sequence.
+l.42 \foo
+         {bar}
+LaTeX Warning: Reference ` + "`" + `fig:cycle' on page 3 undefined on input line 57.
+LaTeX Warning: Citation ` + "`" + `lit:kholshchevnikov' on page 4 undefined on input line 60.
+Overfull \hbox (12.34pt too wide) in paragraph at lines 10--12
+Overfull \vbox (3.0pt too high) has occurred while \output is active
+LaTeX Warning: Label(s) may have changed. Rerun to get cross-references right.
+`
+
+func TestParseLog(t *testing.T) {
+	var report, err = ParseLog(strings.NewReader(sampleLog))
+	assert.Nil(t, err)
+
+	assert.Equal(t, []LogEntry{{Line: 42, Message: "Undefined control sequence."}}, report.Errors)
+	assert.Equal(t, []LogEntry{{Line: 57, Message: "fig:cycle"}}, report.UndefinedReferences)
+	assert.Equal(t, []LogEntry{{Line: 60, Message: "lit:kholshchevnikov"}}, report.UndefinedCitations)
+
+	assert.Len(t, report.OverfullBoxes, 2)
+	assert.Equal(t, 10, report.OverfullBoxes[0].Line)
+	assert.InDelta(t, 12.34, report.OverfullBoxes[0].Excess, 1e-9)
+	assert.Equal(t, `Overfull \hbox (12.34pt too wide) in paragraph`, report.OverfullBoxes[0].Message)
+	assert.Equal(t, 0, report.OverfullBoxes[1].Line)
+
+	assert.True(t, report.NeedsRerun)
+	assert.True(t, report.Failed())
+}
+
+func TestParseLog_WrappedLines(t *testing.T) {
+	var first = "LaTeX Warning: Reference `fig:very-long-label-name-that-is-wrapped-by-tex' on p"
+	assert.Len(t, first, fileLineWidth)
+
+	var report, err = ParseLog(strings.NewReader(first + "\nage 3 undefined on input line 7.\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []LogEntry{{Line: 7, Message: "fig:very-long-label-name-that-is-wrapped-by-tex"}}, report.UndefinedReferences)
+}
+
+func TestParseLog_Clean(t *testing.T) {
+	var report, err = ParseLog(strings.NewReader("(./root.tex)\nOutput written on root.pdf (10 pages).\n"))
+	assert.Nil(t, err)
+	assert.False(t, report.Failed())
+	assert.False(t, report.NeedsRerun)
+}
@@ -0,0 +1,81 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/midall"
+)
+
+func NewCompressorFitDF(name, title string, diag midall.CompressorFitDiagnostics) CompressorFitDF {
+	return CompressorFitDF{
+		Name:  name,
+		Title: title,
+
+		HtFactor:            diag.HtFactor,
+		EtaFactor:           diag.EtaFactor,
+		ResidualEvaluations: diag.ResidualEvaluations,
+
+		CyclePi:     diag.CyclePi,
+		StagedPi:    diag.StagedPi,
+		PiMismatch:  diag.PiMismatch(),
+		CycleEta:    diag.CycleEta,
+		StagedEta:   diag.StagedEta,
+		EtaMismatch: diag.EtaMismatch(),
+
+		HtLimit:     diag.HtLimit,
+		EtaLimit:    diag.EtaLimit,
+		HtLimitHit:  diag.HtLimitHit(),
+		EtaLimitHit: diag.EtaLimitHit(),
+		Stages:      diag.Stages,
+	}
+}
+
+type CompressorFitDF struct {
+	Name  string // используется в метках таблиц
+	Title string
+
+	HtFactor            float64
+	EtaFactor           float64
+	ResidualEvaluations int
+
+	CyclePi     float64
+	StagedPi    float64
+	PiMismatch  float64
+	CycleEta    float64
+	StagedEta   float64
+	EtaMismatch float64
+
+	HtLimit     float64
+	EtaLimit    float64
+	HtLimitHit  bool
+	EtaLimitHit bool
+	Stages      []midall.StageLoading
+}
+
+type CompressorFitTableRow struct {
+	Id         int
+	HtCoef     float64
+	Eta        float64
+	Pi         float64
+	HtLimited  bool
+	EtaLimited bool
+}
+
+func (df CompressorFitDF) TableRows() chan CompressorFitTableRow {
+	var iterFunc = func(ch chan CompressorFitTableRow) {
+		for i, s := range df.Stages {
+			ch <- CompressorFitTableRow{
+				Id:         i + 1,
+				HtCoef:     s.HtCoef,
+				Eta:        s.Eta,
+				Pi:         s.Pi,
+				HtLimited:  s.HtLimited,
+				EtaLimited: s.EtaLimited,
+			}
+		}
+		close(ch)
+	}
+
+	var result = make(chan CompressorFitTableRow)
+	go iterFunc(result)
+
+	return result
+}
@@ -0,0 +1,40 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	templ2 "github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+const (
+	compressorFitTemplateFilePath = "../templates/compressor_fit_template.tex"
+)
+
+func TestCompressorFitDF_TemplateSmoke(t *testing.T) {
+	var df = NewCompressorFitDF("lpc", "КНД", midall.CompressorFitDiagnostics{
+		HtFactor: 1.1, EtaFactor: 0.98, ResidualEvaluations: 12,
+		CyclePi: 4, StagedPi: 4.02, CycleEta: 0.86, StagedEta: 0.855,
+		HtLimit: 0.5, EtaLimit: 0.9,
+		Stages: []midall.StageLoading{
+			{HtCoef: 0.3, Eta: 0.88, Pi: 1.3},
+			{HtCoef: 0.5, Eta: 0.9, Pi: 1.35, HtLimited: true, EtaLimited: true},
+		},
+	})
+	assert.InDelta(t, 0.005, df.PiMismatch, 1e-9)
+	assert.True(t, df.HtLimitHit)
+	assert.True(t, df.EtaLimitHit)
+
+	var i = 0
+	for range df.TableRows() {
+		i++
+	}
+	assert.Equal(t, 2, i)
+
+	f, err := ioutil.ReadFile(compressorFitTemplateFilePath)
+	assert.NoError(t, err)
+	templ, err := templ2.GetTemplate("fit", string(f), templ2.GetFuncMap())
+	assert.NoError(t, err)
+	assert.NoError(t, templ.Execute(ioutil.Discard, []CompressorFitDF{df}))
+}
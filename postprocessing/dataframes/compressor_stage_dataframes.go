@@ -1,6 +1,7 @@
 package dataframes
 
 import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
 	"github.com/Sovianum/turbocycle/common"
 	"github.com/Sovianum/turbocycle/impl/engine/nodes"
 	"github.com/Sovianum/turbocycle/impl/stage/compressor"
@@ -178,8 +179,8 @@ func NewCompressorBladingGeomDF(
 		RRelOut:    geometry.RRel(geometry.DRel(geom.XGapOut(), geom)),
 		Elongation: geomGen.Elongation(),
 		DeltaRel:   geomGen.DeltaRel(),
-		GammaIn:    geomGen.GammaIn(),
-		GammaOut:   geomGen.GammaOut(),
+		GammaIn:    units.Radian(geomGen.GammaIn()),
+		GammaOut:   units.Radian(geomGen.GammaOut()),
 		AreaIn:     geometry.Area(0, geom),
 		AreaOut:    geometry.Area(geom.XGapOut(), geom),
 		DOutIn:     geom.OuterProfile().Diameter(0),
@@ -197,8 +198,8 @@ type CompressorBladingGeomDF struct {
 	RRelOut    float64
 	Elongation float64
 	DeltaRel   float64
-	GammaIn    float64
-	GammaOut   float64
+	GammaIn    units.Radian
+	GammaOut   units.Radian
 	AreaIn     float64
 	AreaOut    float64
 	DOutIn     float64
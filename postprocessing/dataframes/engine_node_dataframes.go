@@ -1,6 +1,7 @@
 package dataframes
 
 import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
 	"github.com/Sovianum/turbocycle/core/graph"
 	"github.com/Sovianum/turbocycle/impl/engine/nodes"
 	"github.com/Sovianum/turbocycle/impl/engine/nodes/constructive"
@@ -10,8 +11,8 @@ import (
 
 func NewGasDF(p, t float64, gas gases.Gas) GasDF {
 	return GasDF{
-		T:       t,
-		P:       p,
+		T:       units.Kelvin(t),
+		P:       units.Pascal(p),
 		Density: gases.Density(gas, t, p),
 		K:       gases.K(gas, t),
 		Cp:      gas.Cp(t),
@@ -20,19 +21,19 @@ func NewGasDF(p, t float64, gas gases.Gas) GasDF {
 }
 
 type GasDF struct {
-	T       float64 `json:"t"`
-	P       float64 `json:"p"`
-	Density float64 `json:"density"`
-	K       float64 `json:"k"`
-	Cp      float64 `json:"cp"`
-	R       float64 `json:"r"`
+	T       units.Kelvin `json:"t"`
+	P       units.Pascal `json:"p"`
+	Density float64      `json:"density"`
+	K       float64      `json:"k"`
+	Cp      float64      `json:"cp"`
+	R       float64      `json:"r"`
 }
 
 func NewGasMeanDF(p, t1, t2 float64, gas gases.Gas) GasMeanDF {
 	return GasMeanDF{
-		T1:     t1,
-		T2:     t2,
-		P:      p,
+		T1:     units.Kelvin(t1),
+		T2:     units.Kelvin(t2),
+		P:      units.Pascal(p),
 		KMean:  gases.KMean(gas, t1, t2, nodes.DefaultN),
 		CpMean: gases.CpMean(gas, t1, t2, nodes.DefaultN),
 		R:      gas.R(),
@@ -40,24 +41,24 @@ func NewGasMeanDF(p, t1, t2 float64, gas gases.Gas) GasMeanDF {
 }
 
 type GasMeanDF struct {
-	T1     float64 `json:"t_1"`
-	T2     float64 `json:"t_2"`
-	P      float64 `json:"p"`
-	KMean  float64 `json:"k_mean"`
-	CpMean float64 `json:"cp_mean"`
-	R      float64 `json:"r"`
+	T1     units.Kelvin `json:"t_1"`
+	T2     units.Kelvin `json:"t_2"`
+	P      units.Pascal `json:"p"`
+	KMean  float64      `json:"k_mean"`
+	CpMean float64      `json:"cp_mean"`
+	R      float64      `json:"r"`
 }
 
 func NewCompressorDF(node constructive.CompressorNode) CompressorDF {
 	return CompressorDF{
-		PIn:  node.PStagIn(),
-		POut: node.PStagOut(),
+		PIn:  units.Pascal(node.PStagIn()),
+		POut: units.Pascal(node.PStagOut()),
 
-		TIn:  node.TStagIn(),
-		TOut: node.TStagOut(),
+		TIn:  units.Kelvin(node.TStagIn()),
+		TOut: units.Kelvin(node.TStagOut()),
 
 		Pi:     node.PiStag(),
-		Labour: node.LSpecific(),
+		Labour: units.JPerKg(node.LSpecific()),
 		Eta:    node.Eta(),
 		EtaPol: node.EtaPol(),
 
@@ -71,37 +72,37 @@ func NewCompressorDF(node constructive.CompressorNode) CompressorDF {
 }
 
 type CompressorDF struct {
-	PIn  float64 `json:"p_in"`
-	POut float64 `json:"p_out"`
+	PIn  units.Pascal `json:"p_in"`
+	POut units.Pascal `json:"p_out"`
 
-	TIn  float64 `json:"t_in"`
-	TOut float64 `json:"t_out"`
+	TIn  units.Kelvin `json:"t_in"`
+	TOut units.Kelvin `json:"t_out"`
 
 	Pi     float64 `json:"pi"`
 	Eta    float64 `json:"eta"`
 	EtaPol float64 `json:"eta_pol"`
 
-	Labour float64 `json:"labour"`
+	Labour units.JPerKg `json:"labour"`
 
 	GasData GasMeanDF `json:"gas_data"`
 }
 
 func NewPressureDropDF(node constructive.PressureLossNode) PressureDropDF {
 	return PressureDropDF{
-		PIn:   node.PStagIn(),
-		POut:  node.PStagOut(),
-		TIn:   node.TStagIn(),
-		TOut:  node.TStagOut(),
+		PIn:   units.Pascal(node.PStagIn()),
+		POut:  units.Pascal(node.PStagOut()),
+		TIn:   units.Kelvin(node.TStagIn()),
+		TOut:  units.Kelvin(node.TStagOut()),
 		Sigma: node.Sigma(),
 	}
 }
 
 type PressureDropDF struct {
-	PIn  float64 `json:"p_in"`
-	POut float64 `json:"p_out"`
+	PIn  units.Pascal `json:"p_in"`
+	POut units.Pascal `json:"p_out"`
 
-	TIn  float64 `json:"t_in"`
-	TOut float64 `json:"t_out"`
+	TIn  units.Kelvin `json:"t_in"`
+	TOut units.Kelvin `json:"t_out"`
 
 	Sigma float64 `json:"sigma"`
 }
@@ -110,50 +111,73 @@ func NewRegeneratorNode(node constructive.RegeneratorNode) RegeneratorDF {
 	extractor := func(port graph.Port) float64 { return port.GetState().Value().(float64) }
 
 	return RegeneratorDF{
-		PColdIn:  extractor(node.ColdInput().PressureInput()),
-		PColdOut: extractor(node.ColdOutput().PressureOutput()),
-		PHotIn:   extractor(node.HotInput().PressureInput()),
-		PHotOut:  extractor(node.HotOutput().PressureOutput()),
+		PColdIn:  units.Pascal(extractor(node.ColdInput().PressureInput())),
+		PColdOut: units.Pascal(extractor(node.ColdOutput().PressureOutput())),
+		PHotIn:   units.Pascal(extractor(node.HotInput().PressureInput())),
+		PHotOut:  units.Pascal(extractor(node.HotOutput().PressureOutput())),
 
-		TColdIn:  extractor(node.ColdInput().TemperatureInput()),
-		TColdOut: extractor(node.ColdOutput().TemperatureOutput()),
-		THotIn:   extractor(node.HotInput().TemperatureInput()),
-		THotOut:  extractor(node.HotOutput().TemperatureOutput()),
+		TColdIn:  units.Kelvin(extractor(node.ColdInput().TemperatureInput())),
+		TColdOut: units.Kelvin(extractor(node.ColdOutput().TemperatureOutput())),
+		THotIn:   units.Kelvin(extractor(node.HotInput().TemperatureInput())),
+		THotOut:  units.Kelvin(extractor(node.HotOutput().TemperatureOutput())),
 
 		Sigma: node.Sigma(),
 	}
 }
 
 type RegeneratorDF struct {
-	PColdIn  float64 `json:"p_cold_in"`
-	PColdOut float64 `json:"p_cold_out"`
-	PHotIn   float64 `json:"p_hot_in"`
-	PHotOut  float64 `json:"p_hot_out"`
+	PColdIn  units.Pascal `json:"p_cold_in"`
+	PColdOut units.Pascal `json:"p_cold_out"`
+	PHotIn   units.Pascal `json:"p_hot_in"`
+	PHotOut  units.Pascal `json:"p_hot_out"`
 
-	TColdIn  float64 `json:"t_cold_in"`
-	TColdOut float64 `json:"t_cold_out"`
-	THotIn   float64 `json:"t_hot_in"`
-	THotOut  float64 `json:"t_hot_out"`
+	TColdIn  units.Kelvin `json:"t_cold_in"`
+	TColdOut units.Kelvin `json:"t_cold_out"`
+	THotIn   units.Kelvin `json:"t_hot_in"`
+	THotOut  units.Kelvin `json:"t_hot_out"`
 
 	Sigma float64
 }
 
+func NewCoolerDF(node constructive.CoolerNode) CoolerDF {
+	extractor := func(port graph.Port) float64 { return port.GetState().Value().(float64) }
+
+	return CoolerDF{
+		PIn:  units.Pascal(extractor(node.PressureInput())),
+		POut: units.Pascal(extractor(node.PressureOutput())),
+		TIn:  units.Kelvin(extractor(node.TemperatureInput())),
+		TOut: units.Kelvin(extractor(node.TemperatureOutput())),
+
+		Sigma: node.Sigma(),
+	}
+}
+
+type CoolerDF struct {
+	PIn  units.Pascal `json:"p_in"`
+	POut units.Pascal `json:"p_out"`
+
+	TIn  units.Kelvin `json:"t_in"`
+	TOut units.Kelvin `json:"t_out"`
+
+	Sigma float64 `json:"sigma"`
+}
+
 func NewFuelDF(TInit, T0 float64, fuel fuel.GasFuel) FuelDF {
 	return FuelDF{
 		C:      fuel.Cp(T0),
-		TInit:  TInit,
-		T0:     T0,
-		QLower: fuel.QLower(),
+		TInit:  units.Kelvin(TInit),
+		T0:     units.Kelvin(T0),
+		QLower: units.JPerKg(fuel.QLower()),
 		L0:     fuel.GasMassTheory(gases.GetAir()),
 	}
 }
 
 type FuelDF struct {
-	C      float64 `json:"c"`
-	TInit  float64 `json:"t_init"`
-	T0     float64 `json:"t_0"`
-	QLower float64 `json:"q_lower"`
-	L0     float64 `json:"l_0"`
+	C      float64      `json:"c"`
+	TInit  units.Kelvin `json:"t_init"`
+	T0     units.Kelvin `json:"t_0"`
+	QLower units.JPerKg `json:"q_lower"`
+	L0     float64      `json:"l_0"`
 }
 
 func NewBurnerDF(node constructive.BurnerNode) BurnerDF {
@@ -162,7 +186,7 @@ func NewBurnerDF(node constructive.BurnerNode) BurnerDF {
 	var outletGas = node.GasOutput().GetState().Value().(gases.Gas)
 
 	var df = BurnerDF{
-		Tg:              node.TStagOut(),
+		Tg:              units.Kelvin(node.TStagOut()),
 		Eta:             node.Eta(),
 		Alpha:           node.Alpha(),
 		FuelMassRateRel: node.FuelRateRel(),
@@ -194,20 +218,20 @@ func NewBurnerDF(node constructive.BurnerNode) BurnerDF {
 		),
 	}
 
-	df.A = df.GasDataOutlet.Cp*df.Tg - df.AirDataInlet.Cp*node.TemperatureInput().GetState().Value().(float64)
+	df.A = df.GasDataOutlet.Cp*float64(df.Tg) - df.AirDataInlet.Cp*node.TemperatureInput().GetState().Value().(float64)
 	df.B = (df.GasData0.Cp - df.AirData0.Cp) * t0
-	df.C = df.GasDataOutlet.Cp*df.Tg - df.GasData0.Cp*t0
+	df.C = df.GasDataOutlet.Cp*float64(df.Tg) - df.GasData0.Cp*t0
 	df.D = df.Fuel.C * (node.TFuel() - t0)
 
 	return df
 }
 
 type BurnerDF struct {
-	Tg              float64 `json:"tg"`
-	Eta             float64 `json:"eta"`
-	Alpha           float64 `json:"alpha"`
-	FuelMassRateRel float64 `json:"fuel_mass_rate_rel"`
-	Sigma           float64 `json:"sigma"`
+	Tg              units.Kelvin `json:"tg"`
+	Eta             float64      `json:"eta"`
+	Alpha           float64      `json:"alpha"`
+	FuelMassRateRel float64      `json:"fuel_mass_rate_rel"`
+	Sigma           float64      `json:"sigma"`
 
 	A float64
 	B float64
@@ -227,11 +251,11 @@ func NewTurbineDFFromBlockedTurbine(node constructive.BlockedTurbineNode) Turbin
 	outletGas := node.GasOutput().GetState().Value().(gases.Gas)
 
 	return TurbineDF{
-		PIn:  node.PStagIn(),
-		POut: node.PStagOut(),
+		PIn:  units.Pascal(node.PStagIn()),
+		POut: units.Pascal(node.PStagOut()),
 
-		TIn:  node.TStagIn(),
-		TOut: node.TStagOut(),
+		TIn:  units.Kelvin(node.TStagIn()),
+		TOut: units.Kelvin(node.TStagOut()),
 
 		InletGasData:  NewGasDF(node.PStagIn(), node.TStagIn(), inletGas),
 		OutletGasData: NewGasDF(node.PStagOut(), node.TStagOut(), outletGas),
@@ -247,10 +271,10 @@ func NewTurbineDFFromBlockedTurbine(node constructive.BlockedTurbineNode) Turbin
 		CoolMassRateRel: node.CoolMassRateRel(),
 
 		LambdaOut: node.LambdaOut(),
-		POutStat:  constructive.POut(node),
-		TOutStat:  constructive.TOut(node),
+		POutStat:  units.Pascal(constructive.POut(node)),
+		TOutStat:  units.Kelvin(constructive.TOut(node)),
 
-		Labour: node.LSpecific(),
+		Labour: units.JPerKg(node.LSpecific()),
 		Eta:    node.Eta(),
 	}
 }
@@ -260,11 +284,11 @@ func NewTurbineDFFromFreeTurbine(node constructive.FreeTurbineNode) TurbineDF {
 	outletGas := node.GasOutput().GetState().Value().(gases.Gas)
 
 	return TurbineDF{
-		PIn:  node.PStagIn(),
-		POut: node.PStagOut(),
+		PIn:  units.Pascal(node.PStagIn()),
+		POut: units.Pascal(node.PStagOut()),
 
-		TIn:  node.TStagIn(),
-		TOut: node.TStagOut(),
+		TIn:  units.Kelvin(node.TStagIn()),
+		TOut: units.Kelvin(node.TStagOut()),
 
 		InletGasData:  NewGasDF(node.PStagIn(), node.TStagIn(), inletGas),
 		OutletGasData: NewGasDF(node.PStagOut(), node.TStagOut(), outletGas),
@@ -280,20 +304,20 @@ func NewTurbineDFFromFreeTurbine(node constructive.FreeTurbineNode) TurbineDF {
 		CoolMassRateRel: node.CoolMassRateRel(),
 
 		LambdaOut: node.LambdaOut(),
-		POutStat:  constructive.POut(node),
-		TOutStat:  constructive.TOut(node),
+		POutStat:  units.Pascal(constructive.POut(node)),
+		TOutStat:  units.Kelvin(constructive.TOut(node)),
 
-		Labour: node.LSpecific(),
+		Labour: units.JPerKg(node.LSpecific()),
 		Eta:    node.Eta(),
 	}
 }
 
 type TurbineDF struct {
-	PIn  float64 `json:"p_in"`
-	POut float64 `json:"p_out"`
+	PIn  units.Pascal `json:"p_in"`
+	POut units.Pascal `json:"p_out"`
 
-	TIn  float64 `json:"t_in"`
-	TOut float64 `json:"t_out"`
+	TIn  units.Kelvin `json:"t_in"`
+	TOut units.Kelvin `json:"t_out"`
 
 	InletGasData  GasDF     `json:"inlet_gas_data"`
 	OutletGasData GasDF     `json:"outlet_gas_data"`
@@ -303,20 +327,22 @@ type TurbineDF struct {
 	LeakMassRateRel float64 `json:"leak_mass_rate_rel"`
 	CoolMassRateRel float64 `json:"cool_mass_rate_rel"`
 
-	LambdaOut float64 `json:"lambda_out"`
-	POutStat  float64 `json:"p_out_stat"`
-	TOutStat  float64 `json:"t_out_stat"`
+	LambdaOut float64      `json:"lambda_out"`
+	POutStat  units.Pascal `json:"p_out_stat"`
+	TOutStat  units.Kelvin `json:"t_out_stat"`
 
-	Labour float64 `json:"labour"`
-	Eta    float64 `json:"eta"`
+	Labour units.JPerKg `json:"labour"`
+	Eta    float64      `json:"eta"`
 }
 
 func NewShaftDF(node constructive.TransmissionNode) ShaftDF {
 	return ShaftDF{
-		Eta: node.Eta(),
+		Eta:   node.Eta(),
+		Power: node.PowerOutput().GetState().Value().(float64),
 	}
 }
 
 type ShaftDF struct {
-	Eta float64 `json:"eta"`
+	Eta   float64 `json:"eta"`
+	Power float64 `json:"power"` // передаваемая валом удельная работа
 }
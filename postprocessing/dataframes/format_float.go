@@ -2,11 +2,11 @@ package dataframes
 
 import (
 	"fmt"
-	"strings"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
 )
 
 func changeDecimal(string string) string {
-	return strings.Replace(string, ".", ",", -1)
+	return locale.Decimal(string)
 }
 
 func Round(value float64) string {
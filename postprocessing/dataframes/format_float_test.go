@@ -0,0 +1,20 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	assert.Equal(t, "1,50", Round2(1.5))
+	assert.Equal(t, "2", Round(1.5))
+}
+
+func TestRound_English(t *testing.T) {
+	defer locale.Set(locale.Current())
+	locale.Set(locale.English)
+
+	assert.Equal(t, "1.50", Round2(1.5))
+	assert.Equal(t, "0.125", Round3(0.125))
+}
@@ -1,7 +1,9 @@
 package dataframes
 
 import (
+	"github.com/Sovianum/cooling-course-project/core/cooling/nusselt"
 	"github.com/Sovianum/turbocycle/utils/turbine/cooling/gap"
+	"math"
 )
 
 func GapCalcFromDataPacks(packArr []gap.DataPack) GapCalcDF {
@@ -101,6 +103,16 @@ type GapGasDF struct {
 	ReGas       float64
 	NuGas       float64
 	NuCoef      float64
+	PrGas       float64
+
+	NuLawName      string
+	NuLawTitle     string
+	NuFormula      string
+	NuSubstitution string
+	NuReMin        float64
+	NuReMax        float64
+	NuReRange      string // диапазон применимости в формате LaTeX
+	NuReInRange    bool
 
 	Theta0 float64
 
@@ -113,6 +125,33 @@ type GapGasDF struct {
 	AirGap      []float64
 }
 
+func (df *GapGasDF) SetNuCorrelation(correlation nusselt.Correlation, pr float64) {
+	df.PrGas = pr
+	df.NuLawName = correlation.Name()
+	df.NuLawTitle = correlation.Title()
+	df.NuFormula = correlation.Formula()
+	df.NuSubstitution = correlation.Substitution(df.ReGas, pr)
+	df.NuReMin, df.NuReMax = correlation.ReRange()
+	df.NuReRange = reRange(df.NuReMin, df.NuReMax)
+	df.NuReInRange = nusselt.Warning(correlation, df.ReGas, pr) == nil
+
+	if law, ok := correlation.(interface{ Coef() float64 }); ok {
+		df.NuCoef = law.Coef()
+	}
+}
+
+// reRange записывает диапазон чисел Re в тысячах; неограниченная сторона диапазона не выводится
+func reRange(reMin, reMax float64) string {
+	var result = "Re"
+	if reMin > 0 {
+		result = Round(DivideE3(reMin)) + " \\cdot 10^3 \\le " + result
+	}
+	if !math.IsInf(reMax, 1) {
+		result += " \\le " + Round(DivideE3(reMax)) + " \\cdot 10^3"
+	}
+	return result
+}
+
 type GapTableRow struct {
 	Id          int
 	AirMassRate float64
@@ -1,7 +1,9 @@
 package dataframes
 
 import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
 	"github.com/stretchr/testify/assert"
+	"math"
 	"testing"
 )
 
@@ -18,3 +20,12 @@ func TestGapGasDF_AirDataIterator(t *testing.T) {
 	}
 	assert.Equal(t, 3, i)
 }
+
+func TestReRange(t *testing.T) {
+	defer locale.Set(locale.Current())
+	locale.Set(locale.English)
+
+	assert.Equal(t, "10 \\cdot 10^3 \\le Re \\le 120 \\cdot 10^3", reRange(1e4, 1.2e5))
+	assert.Equal(t, "10 \\cdot 10^3 \\le Re", reRange(1e4, math.Inf(1)))
+	assert.Equal(t, "Re \\le 100 \\cdot 10^3", reRange(0, 1e5))
+}
@@ -0,0 +1,116 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
+	"github.com/Sovianum/turbocycle/core/graph"
+	"github.com/Sovianum/turbocycle/material/gases"
+)
+
+// NewGraphDF строит таблицу параметров всех узлов решенной схемы без знания ее топологии.
+// Величины, которых у узла нет, равны нулю.
+func NewGraphDF(scheme interface{}) GraphDF {
+	var df GraphDF
+	WalkScheme(scheme, df.visit)
+	return df
+}
+
+func NewGraphDFFromNodes(roots []graph.Node) GraphDF {
+	var df GraphDF
+	WalkNodes(roots, df.visit)
+	return df
+}
+
+type GraphDF struct {
+	Rows  []GraphTableRow `json:"rows"`
+	Ports int             `json:"ports"`
+}
+
+type GraphTableRow struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+
+	TIn  units.Kelvin `json:"t_in"`
+	TOut units.Kelvin `json:"t_out"`
+	PIn  units.Pascal `json:"p_in"`
+	POut units.Pascal `json:"p_out"`
+
+	MassRateIn  units.KgPerSecond `json:"mass_rate_in"`
+	MassRateOut units.KgPerSecond `json:"mass_rate_out"`
+
+	GasR  float64 `json:"gas_r"`
+	Power float64 `json:"power"`
+
+	node graph.Node
+}
+
+func (df GraphDF) Nodes() []graph.Node {
+	var result = make([]graph.Node, len(df.Rows))
+	for i, row := range df.Rows {
+		result[i] = row.node
+	}
+	return result
+}
+
+func (df GraphDF) TableRows() chan GraphTableRow {
+	var iterFunc = func(ch chan GraphTableRow) {
+		for _, row := range df.Rows {
+			ch <- row
+		}
+		close(ch)
+	}
+
+	var result = make(chan GraphTableRow)
+	go iterFunc(result)
+
+	return result
+}
+
+func (df *GraphDF) visit(name string, node graph.Node, ports map[string]graph.Port) {
+	df.Ports += len(ports)
+	df.Rows = append(df.Rows, GraphTableRow{
+		Id:   len(df.Rows) + 1,
+		Name: name,
+		Type: nodeTypeName(node),
+
+		TIn:  units.Kelvin(portFloat(ports, "TemperatureInput")),
+		TOut: units.Kelvin(portFloat(ports, "TemperatureOutput")),
+		PIn:  units.Pascal(portFloat(ports, "PressureInput")),
+		POut: units.Pascal(portFloat(ports, "PressureOutput")),
+
+		MassRateIn:  units.KgPerSecond(portFloat(ports, "MassRateInput")),
+		MassRateOut: units.KgPerSecond(portFloat(ports, "MassRateOutput")),
+
+		GasR:  portGasR(ports, "GasInput", "GasOutput"),
+		Power: portFloat(ports, "PowerOutput", "PowerInput"),
+
+		node: node,
+	})
+}
+
+// portFloat возвращает значение первого из найденных портов, состояние которого задано
+func portFloat(ports map[string]graph.Port, names ...string) float64 {
+	for _, name := range names {
+		if value, ok := portValue(ports, name).(float64); ok {
+			return value
+		}
+	}
+	return 0
+}
+
+func portGasR(ports map[string]graph.Port, names ...string) float64 {
+	for _, name := range names {
+		if gas, ok := portValue(ports, name).(gases.Gas); ok {
+			return gas.R()
+		}
+	}
+	return 0
+}
+
+func portValue(ports map[string]graph.Port, name string) interface{} {
+	var port, ok = ports[name]
+	if !ok || port.GetState() == nil {
+		return nil
+	}
+	return port.GetState().Value()
+}
@@ -0,0 +1,102 @@
+package dataframes
+
+import (
+	"encoding/json"
+	"github.com/Sovianum/cooling-course-project/core"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3n"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nsc"
+	templ2 "github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+const graphTemplateFilePath = "../templates/graph_table_template.tex"
+
+func TestNewGraphDF_ThreeShafts(t *testing.T) {
+	var scheme = s3n.GetInitedThreeShaftsScheme()
+	solveDouble(t, scheme)
+
+	var df = NewGraphDF(scheme)
+	assert.True(t, df.Ports > 0)
+
+	var byNode = make(map[interface{}]GraphTableRow)
+	for i, row := range df.Rows {
+		assert.Equal(t, i+1, row.Id)
+		assert.NotEmpty(t, row.Name)
+		assert.NotEmpty(t, row.Type)
+		byNode[row.node] = row
+	}
+
+	// узлы встречаются в таблице по одному разу
+	assert.Equal(t, len(df.Rows), len(byNode))
+
+	var lpc = byNode[scheme.LPC()]
+	assert.Equal(t, scheme.LPC().TStagIn(), float64(lpc.TIn))
+	assert.Equal(t, scheme.LPC().PStagOut(), float64(lpc.POut))
+
+	var hpt = byNode[scheme.HPT()]
+	assert.Equal(t, scheme.HPT().TStagIn(), float64(hpt.TIn))
+	assert.True(t, hpt.GasR > 0)
+
+	// вложенные узлы составных узлов
+	var burner = byNode[scheme.GasGenerator().Burner()]
+	assert.Equal(t, scheme.GasGenerator().Burner().TStagOut(), float64(burner.TOut))
+
+	var hpShaft, hpOk = byNode[scheme.GasGenerator().TurboCascade().Transmission()]
+	var lpShaft, lpOk = byNode[scheme.MiddlePressureCascade().Transmission()]
+	assert.True(t, hpOk && lpOk)
+	assert.NotEqual(t, hpShaft.Power, lpShaft.Power)
+
+	var ft, ftOk = byNode[scheme.FTBlock().FreeTurbine()]
+	assert.True(t, ftOk)
+	assert.Equal(t, scheme.FTBlock().FreeTurbine().PStagOut(), float64(ft.POut))
+
+	var threeShafts = NewThreeShaftsDF(power, 0.93, scheme)
+	assert.Equal(t, threeShafts.HPShaft.Power, hpShaft.Power)
+	assert.Equal(t, threeShafts.LPShaft.Power, lpShaft.Power)
+	assert.Equal(t, float64(threeShafts.LPCompressor.TIn), float64(lpc.TIn))
+	assert.Equal(t, float64(threeShafts.HPTurbine.POut), float64(hpt.POut))
+
+	var _, err = json.Marshal(df)
+	assert.Nil(t, err)
+}
+
+func TestNewGraphDF_SubCompress(t *testing.T) {
+	var scheme = s3nsc.GetInitedThreeShaftsSubCompressScheme()
+	solveDouble(t, scheme)
+
+	var df = NewGraphDF(scheme)
+	var byNode = make(map[interface{}]GraphTableRow)
+	for _, row := range df.Rows {
+		byNode[row.node] = row
+	}
+
+	// узлы контура дожимающего компрессора
+	var _, splitterOk = byNode[scheme.GasSplitter()]
+	assert.True(t, splitterOk)
+
+	var subCompressor, compressorOk = byNode[scheme.SubCompressor()]
+	assert.True(t, compressorOk)
+	assert.Equal(t, scheme.SubCompressor().TStagIn(), float64(subCompressor.TIn))
+	assert.Equal(t, scheme.SubCompressor().PStagOut(), float64(subCompressor.POut))
+
+	var subCooler, coolerOk = byNode[scheme.SubCooler()]
+	assert.True(t, coolerOk)
+	assert.True(t, subCooler.TOut > 0)
+}
+
+func TestGraphTemplateSmoke(t *testing.T) {
+	var f, fileErr = ioutil.ReadFile(graphTemplateFilePath)
+	assert.Nil(t, fileErr)
+
+	var templ, tErr = templ2.GetTemplate("graph", string(f), templ2.GetFuncMap())
+	assert.Nil(t, tErr)
+
+	var scheme = s3n.GetInitedThreeShaftsScheme()
+	var generator = core.GetDoubleCompressorDataGenerator(scheme, power, relaxCoef, iterNum)
+	var _, err = generator(10, 0.5)
+	assert.Nil(t, err)
+
+	assert.Nil(t, templ.Execute(ioutil.Discard, NewGraphDF(scheme)))
+}
@@ -0,0 +1,155 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/turbocycle/core/graph"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type portedNode interface {
+	GetPorts() []graph.Port
+}
+
+type linkedPort interface {
+	GetOuterNode() graph.Node
+}
+
+type nameGetter interface {
+	GetName() string
+}
+
+// GraphVisitor получает каждый узел ровно один раз вместе с его портами,
+// доступными через методы без аргументов (TemperatureInput, PressureOutput и т.д.).
+// Составной узел получает только порты, не принадлежащие вложенным узлам.
+type GraphVisitor func(name string, node graph.Node, ports map[string]graph.Port)
+
+// WalkScheme обходит все узлы схемы, начиная с ее методов, возвращающих узлы
+func WalkScheme(scheme interface{}, visitor GraphVisitor) {
+	var roots = nodeAccessors(scheme)
+	var walker = newGraphWalker(visitor)
+	for _, root := range roots {
+		walker.walk(root.name, root.node)
+	}
+}
+
+// WalkNodes обходит узлы roots и все узлы, связанные с ними через порты
+func WalkNodes(roots []graph.Node, visitor GraphVisitor) {
+	var walker = newGraphWalker(visitor)
+	for _, root := range roots {
+		walker.walk("", root)
+	}
+}
+
+func newGraphWalker(visitor GraphVisitor) graphWalker {
+	return graphWalker{
+		visited: make(map[graph.Node]bool),
+		ports:   make(map[graph.Port]bool),
+		visitor: visitor,
+	}
+}
+
+type graphWalker struct {
+	visited map[graph.Node]bool
+	ports   map[graph.Port]bool
+	visitor GraphVisitor
+}
+
+func (w graphWalker) walk(name string, node graph.Node) {
+	if node == nil || w.visited[node] {
+		return
+	}
+	w.visited[node] = true
+
+	// вложенные узлы составного узла обходятся первыми, поэтому у него остаются только порты,
+	// не принадлежащие вложенным узлам
+	var children = nodeAccessors(node)
+	for _, child := range children {
+		w.walk(child.name, child.node)
+	}
+
+	var ports = nodePorts(node)
+	if len(children) > 0 {
+		ports = w.unseenPorts(ports)
+		if len(ports) == 0 {
+			return
+		}
+	}
+	for _, port := range ports {
+		w.ports[port] = true
+	}
+	w.visitor(nodeName(name, node), node, ports)
+
+	for _, portName := range sortedPortNames(ports) {
+		if lp, ok := ports[portName].(linkedPort); ok {
+			w.walk("", lp.GetOuterNode())
+		}
+	}
+	if pn, ok := node.(portedNode); ok {
+		for _, port := range pn.GetPorts() {
+			if lp, ok := port.(linkedPort); ok {
+				w.walk("", lp.GetOuterNode())
+			}
+		}
+	}
+}
+
+// unseenPorts возвращает порты, которые еще не были переданы visitor
+func (w graphWalker) unseenPorts(ports map[string]graph.Port) map[string]graph.Port {
+	var result = make(map[string]graph.Port)
+	for name, port := range ports {
+		if !w.ports[port] {
+			result[name] = port
+		}
+	}
+	return result
+}
+
+func sortedPortNames(ports map[string]graph.Port) []string {
+	var result = make([]string, 0, len(ports))
+	for name := range ports {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// nodePorts вызывает все методы узла без аргументов, возвращающие порт
+func nodePorts(node graph.Node) map[string]graph.Port {
+	var portType = reflect.TypeOf((*graph.Port)(nil)).Elem()
+	var v = reflect.ValueOf(node)
+
+	var result = make(map[string]graph.Port)
+	for i := 0; i != v.NumMethod(); i++ {
+		var methodType = v.Method(i).Type()
+		if methodType.NumIn() != 0 || methodType.NumOut() != 1 || !methodType.Out(0).Implements(portType) {
+			continue
+		}
+		var out = v.Method(i).Call(nil)[0]
+		if out.Kind() == reflect.Interface && out.IsNil() {
+			continue
+		}
+		result[v.Type().Method(i).Name] = out.Interface().(graph.Port)
+	}
+	return result
+}
+
+// nodeName возвращает собственное имя узла, если оно задано, иначе имя метода схемы или типа узла
+func nodeName(accessorName string, node graph.Node) string {
+	if ng, ok := node.(nameGetter); ok && ng.GetName() != "" {
+		return ng.GetName()
+	}
+	if accessorName != "" {
+		return accessorName
+	}
+	return nodeTypeName(node)
+}
+
+func nodeTypeName(node graph.Node) string {
+	var name = reflect.TypeOf(node).String()
+	name = strings.TrimLeft(name, "*")
+	if i := strings.LastIndex(name, "."); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
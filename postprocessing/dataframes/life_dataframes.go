@@ -0,0 +1,91 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/life"
+)
+
+func NewLifeDF(conf life.Config, result life.Result) LifeDF {
+	return LifeDF{
+		Material:  result.Material,
+		Density:   conf.Material.Density,
+		E:         conf.Material.E,
+		Alpha:     conf.Material.Alpha,
+		SigmaU:    conf.Material.SigmaU,
+		LMC:       conf.Material.LMC,
+		AreaTaper: conf.AreaTaper,
+		RPM:       result.RPM,
+
+		MinCreepLife: result.MinCreepLife,
+		MinCreepHRel: result.MinCreepHRel,
+		MinLCFCycles: result.MinLCFCycles,
+		MinLCFHRel:   result.MinLCFHRel,
+
+		RequiredLife:   result.RequiredLife,
+		RequiredCycles: result.RequiredCycles,
+		CreepPassed:    result.CreepPassed,
+		LCFPassed:      result.LCFPassed,
+		Passed:         result.Passed(),
+
+		Sections: result.Sections,
+	}
+}
+
+type LifeDF struct {
+	Material  string
+	Density   float64
+	E         float64
+	Alpha     float64
+	SigmaU    float64
+	LMC       float64
+	AreaTaper float64
+	RPM       float64
+
+	MinCreepLife float64
+	MinCreepHRel float64
+	MinLCFCycles float64
+	MinLCFHRel   float64
+
+	RequiredLife   float64
+	RequiredCycles float64
+	CreepPassed    bool
+	LCFPassed      bool
+	Passed         bool
+
+	Sections []life.SectionLife
+}
+
+type LifeTableRow struct {
+	Id            int
+	HRel          float64
+	Radius        float64
+	TWall         float64
+	DTWall        float64
+	Stress        float64
+	ThermalStress float64
+	CreepLife     float64
+	LCFCycles     float64
+}
+
+func (df LifeDF) TableRows() chan LifeTableRow {
+	var iterFunc = func(ch chan LifeTableRow) {
+		for i, s := range df.Sections {
+			ch <- LifeTableRow{
+				Id:            i + 1,
+				HRel:          s.HRel,
+				Radius:        s.Radius,
+				TWall:         s.TWall,
+				DTWall:        s.DTWall,
+				Stress:        s.Stress,
+				ThermalStress: s.ThermalStress,
+				CreepLife:     s.CreepLife,
+				LCFCycles:     s.LCFCycles,
+			}
+		}
+		close(ch)
+	}
+
+	var result = make(chan LifeTableRow)
+	go iterFunc(result)
+
+	return result
+}
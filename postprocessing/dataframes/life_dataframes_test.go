@@ -0,0 +1,38 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/life"
+	templ2 "github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+const (
+	lifeTemplateFilePath = "../templates/life_calc_template.tex"
+)
+
+func TestLifeDF_TemplateSmoke(t *testing.T) {
+	var material, err = life.GetMaterial(life.ZhS6K)
+	assert.NoError(t, err)
+
+	var conf = life.Config{Material: material, AreaTaper: 0.5, RequiredLife: 1e4, RequiredCycles: 1e3}
+	result, err := life.Assess(conf, 12e3, []life.Section{
+		{HRel: 0, Radius: 0.25, TWall: 1100, DTWall: 150},
+		{HRel: 1, Radius: 0.3, TWall: 1100, DTWall: 150},
+	})
+	assert.NoError(t, err)
+	var df = NewLifeDF(conf, result)
+
+	var i = 0
+	for range df.TableRows() {
+		i++
+	}
+	assert.Equal(t, 2, i)
+
+	f, err := ioutil.ReadFile(lifeTemplateFilePath)
+	assert.NoError(t, err)
+	templ, err := templ2.GetTemplate("life", string(f), templ2.GetFuncMap())
+	assert.NoError(t, err)
+	assert.NoError(t, templ.Execute(ioutil.Discard, df))
+}
@@ -0,0 +1,91 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/losses"
+	"github.com/Sovianum/cooling-course-project/core/midall"
+)
+
+func NewTurbineLossDF(name, title string, useLossModel bool, predictions []midall.StageLossPrediction) TurbineLossDF {
+	return TurbineLossDF{
+		Name:         name,
+		Title:        title,
+		UseLossModel: useLossModel,
+		Stages:       predictions,
+	}
+}
+
+// TurbineLossDF - сравнение коэффициентов скорости и КПД ступеней турбины, подобранных по циклу,
+// с оценкой по модели потерь
+type TurbineLossDF struct {
+	Name         string // используется в метках таблиц
+	Title        string
+	UseLossModel bool // в расчете используются распределения из модели потерь
+	Stages       []midall.StageLossPrediction
+}
+
+type TurbineLossTableRow struct {
+	Id int
+	midall.StageLossPrediction
+}
+
+func (df TurbineLossDF) TableRows() chan TurbineLossTableRow {
+	var iterFunc = func(ch chan TurbineLossTableRow) {
+		for i, s := range df.Stages {
+			ch <- TurbineLossTableRow{Id: i + 1, StageLossPrediction: s}
+		}
+		close(ch)
+	}
+
+	var result = make(chan TurbineLossTableRow)
+	go iterFunc(result)
+
+	return result
+}
+
+func NewCompressorLossDF(
+	name, title string, useLossModel bool, limits losses.DiffusionLimits,
+	predictions []midall.CompressorStageLossPrediction,
+) CompressorLossDF {
+	var passed = true
+	for _, p := range predictions {
+		passed = passed && p.Passed()
+	}
+	return CompressorLossDF{
+		Name:         name,
+		Title:        title,
+		UseLossModel: useLossModel,
+		Limits:       limits,
+		Stages:       predictions,
+		Passed:       passed,
+	}
+}
+
+// CompressorLossDF - сравнение КПД ступеней компрессора, подобранных по циклу, с оценкой по модели потерь
+// и проверка диффузорности венцов
+type CompressorLossDF struct {
+	Name         string // используется в метках таблиц
+	Title        string
+	UseLossModel bool // в расчете используется распределение КПД из модели потерь
+	Limits       losses.DiffusionLimits
+	Stages       []midall.CompressorStageLossPrediction
+	Passed       bool
+}
+
+type CompressorLossTableRow struct {
+	Id int
+	midall.CompressorStageLossPrediction
+}
+
+func (df CompressorLossDF) TableRows() chan CompressorLossTableRow {
+	var iterFunc = func(ch chan CompressorLossTableRow) {
+		for i, s := range df.Stages {
+			ch <- CompressorLossTableRow{Id: i + 1, CompressorStageLossPrediction: s}
+		}
+		close(ch)
+	}
+
+	var result = make(chan CompressorLossTableRow)
+	go iterFunc(result)
+
+	return result
+}
@@ -0,0 +1,36 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/losses"
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	templ2 "github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+const (
+	turbineLossTemplateFilePath = "../templates/turbine_losses_template.tex"
+)
+
+func TestTurbineLossDF_TemplateSmoke(t *testing.T) {
+	var df = NewTurbineLossDF("hpt", "ТВД", true, []midall.StageLossPrediction{
+		{
+			PhiFitted: 0.97, PhiPredicted: 0.965, PsiFitted: 0.96, PsiPredicted: 0.955,
+			StatorLoss: losses.RowLoss{Profile: 0.02, Secondary: 0.03, TrailingEdge: 0.01},
+		},
+		{PhiFitted: 0.97, PhiPredicted: 0.97},
+	})
+
+	var ids []int
+	for row := range df.TableRows() {
+		ids = append(ids, row.Id)
+	}
+	assert.Equal(t, []int{1, 2}, ids)
+
+	f, err := ioutil.ReadFile(turbineLossTemplateFilePath)
+	assert.NoError(t, err)
+	templ, err := templ2.GetTemplate("losses", string(f), templ2.GetFuncMap())
+	assert.NoError(t, err)
+	assert.NoError(t, templ.Execute(ioutil.Discard, []TurbineLossDF{df}))
+}
@@ -0,0 +1,89 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+)
+
+func NewProfileQualityDF(name, title string, limits profiling.QualityLimits, sections []profiling.SectionQuality) ProfileQualityDF {
+	var passed = true
+	for _, s := range sections {
+		passed = passed && s.Passed()
+	}
+	return ProfileQualityDF{
+		Name:     name,
+		Title:    title,
+		Limits:   limits,
+		Sections: sections,
+		Passed:   passed,
+	}
+}
+
+type ProfileQualityDF struct {
+	Name     string // используется в метках таблиц
+	Title    string
+	Limits   profiling.QualityLimits
+	Sections []profiling.SectionQuality
+	Passed   bool
+}
+
+type ProfileQualityTableRow struct {
+	Id                   int
+	HRel                 float64
+	TRel                 float64
+	ThroatRel            float64
+	EffectiveOutletAngle float64
+	OutletAngleError     float64
+	Incidence            float64
+	MaxThicknessRel      float64
+	MaxThicknessPos      float64
+	InletEdgeRadiusRel   float64
+	OutletEdgeRadiusRel  float64
+	AreaRatio            float64
+	Passed               bool
+}
+
+func (df ProfileQualityDF) TableRows() chan ProfileQualityTableRow {
+	var iterFunc = func(ch chan ProfileQualityTableRow) {
+		for i, s := range df.Sections {
+			ch <- ProfileQualityTableRow{
+				Id:                   i + 1,
+				HRel:                 s.HRel,
+				TRel:                 s.TRel,
+				ThroatRel:            s.ThroatRel,
+				EffectiveOutletAngle: s.EffectiveOutletAngle,
+				OutletAngleError:     s.OutletAngleError,
+				Incidence:            s.Incidence,
+				MaxThicknessRel:      s.MaxThicknessRel,
+				MaxThicknessPos:      s.MaxThicknessPos,
+				InletEdgeRadiusRel:   s.InletEdgeRadiusRel,
+				OutletEdgeRadiusRel:  s.OutletEdgeRadiusRel,
+				AreaRatio:            s.AreaRatio,
+				Passed:               s.Passed(),
+			}
+		}
+		close(ch)
+	}
+
+	var result = make(chan ProfileQualityTableRow)
+	go iterFunc(result)
+
+	return result
+}
+
+// PassageMatrix возвращает распределение ширины межлопаточного канала по сечениям:
+// [относительная длина корыта, ширина канала / шаг для каждого сечения]
+func (df ProfileQualityDF) PassageMatrix() [][]float64 {
+	if len(df.Sections) == 0 {
+		return nil
+	}
+	var result = make([][]float64, len(df.Sections[0].Passage))
+	for i := range result {
+		result[i] = []float64{df.Sections[0].Passage[i][0]}
+		for _, s := range df.Sections {
+			if i < len(s.Passage) {
+				result[i] = append(result[i], s.Passage[i][1])
+			}
+		}
+	}
+	return result
+}
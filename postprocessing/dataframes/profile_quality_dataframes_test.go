@@ -0,0 +1,35 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	templ2 "github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+const (
+	profileQualityTemplateFilePath = "../templates/profile_quality_template.tex"
+)
+
+func TestProfileQualityDF_TemplateSmoke(t *testing.T) {
+	var sections = []profiling.SectionQuality{
+		{HRel: 0, Passage: [][]float64{{0, 0.8}, {1, 0.4}}, IncidencePassed: true},
+		{HRel: 1, Passage: [][]float64{{0, 0.9}, {1, 0.5}}},
+	}
+	var df = NewProfileQualityDF("stator", "сопловой аппарат", profiling.QualityLimits{}, sections)
+	assert.False(t, df.Passed)
+
+	var i = 0
+	for range df.TableRows() {
+		i++
+	}
+	assert.Equal(t, 2, i)
+	assert.Equal(t, [][]float64{{0, 0.8, 0.9}, {1, 0.4, 0.5}}, df.PassageMatrix())
+
+	f, err := ioutil.ReadFile(profileQualityTemplateFilePath)
+	assert.NoError(t, err)
+	templ, err := templ2.GetTemplate("quality", string(f), templ2.GetFuncMap())
+	assert.NoError(t, err)
+	assert.NoError(t, templ.Execute(ioutil.Discard, []ProfileQualityDF{df}))
+}
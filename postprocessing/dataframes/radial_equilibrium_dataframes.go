@@ -0,0 +1,47 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+)
+
+// NewRadialEquilibriumDF выбирает для таблицы каждую step-ю точку решения уравнения радиального равновесия
+func NewRadialEquilibriumDF(result profiling.StageEquilibrium, step int) RadialEquilibriumDF {
+	var df = RadialEquilibriumDF{NegativeHubReactivity: result.Reactivity[0] < 0}
+	var inlet, outlet = result.Inlet, result.Outlet
+	for i := 0; i < len(inlet.HRel); i += step {
+		df.Rows = append(df.Rows, RadialEquilibriumRow{
+			Id:         len(df.Rows) + 1,
+			HRel:       inlet.HRel[i],
+			R1:         inlet.R[i],
+			CA1:        inlet.CA[i],
+			CU1:        inlet.CU[i],
+			P1:         inlet.P[i],
+			R2:         outlet.R[i],
+			CA2:        outlet.CA[i],
+			CU2:        outlet.CU[i],
+			P2:         outlet.P[i],
+			Reactivity: result.Reactivity[i],
+		})
+	}
+	return df
+}
+
+// RadialEquilibriumDF - распределение параметров по высоте в осевых зазорах ступени турбины
+type RadialEquilibriumDF struct {
+	NegativeHubReactivity bool
+	Rows                  []RadialEquilibriumRow
+}
+
+type RadialEquilibriumRow struct {
+	Id         int
+	HRel       float64
+	R1         float64
+	CA1        float64
+	CU1        float64
+	P1         float64
+	R2         float64
+	CA2        float64
+	CU2        float64
+	P2         float64
+	Reactivity float64
+}
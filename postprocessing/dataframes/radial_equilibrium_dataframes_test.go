@@ -0,0 +1,31 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewRadialEquilibriumDF(t *testing.T) {
+	var station = profiling.Station{
+		HRel: []float64{0, 0.25, 0.5, 0.75, 1},
+		R:    []float64{0.2, 0.225, 0.25, 0.275, 0.3},
+		CA:   []float64{150, 151, 152, 153, 154},
+		CU:   []float64{300, 290, 280, 270, 260},
+		P:    []float64{1e5, 1.1e5, 1.2e5, 1.3e5, 1.4e5},
+	}
+	var result = profiling.StageEquilibrium{
+		Inlet:      station,
+		Outlet:     station,
+		Reactivity: []float64{-0.1, 0.1, 0.2, 0.3, 0.4},
+		Warnings:   []string{"negative hub reactivity -0.100"},
+	}
+
+	var df = NewRadialEquilibriumDF(result, 2)
+	assert.True(t, df.NegativeHubReactivity)
+	assert.Len(t, df.Rows, 3)
+	assert.Equal(t, 3, df.Rows[2].Id)
+	assert.Equal(t, 1., df.Rows[2].HRel)
+	assert.Equal(t, 280., df.Rows[1].CU1)
+	assert.Equal(t, 0.4, df.Rows[2].Reactivity)
+}
@@ -0,0 +1,42 @@
+package dataframes
+
+// RotorCoolingDF - результаты расчета охлаждения рабочей лопатки в сечениях по высоте
+type RotorCoolingDF struct {
+	AirMassRate float64
+	Sections    []RotorCoolingSection
+}
+
+type RotorCoolingSection struct {
+	Id       int
+	HRel     float64
+	Radius   float64
+	W        float64 // относительная скорость газа
+	TStagW   float64 // температура торможения газа в относительном движении
+	PStagW   float64 // давление торможения газа в относительном движении
+	TCooler  float64 // температура охладителя на входе в сечение
+	PCooler  float64 // давление охладителя
+	AlphaGas float64
+	TWallMax float64
+	DTWall   float64 // перепад температур стенки в сечении
+}
+
+// HRel, TWallMax и DTWall возвращают распределения по высоте лопатки
+func (df RotorCoolingDF) HRel() []float64 {
+	return df.column(func(s RotorCoolingSection) float64 { return s.HRel })
+}
+
+func (df RotorCoolingDF) TWallMax() []float64 {
+	return df.column(func(s RotorCoolingSection) float64 { return s.TWallMax })
+}
+
+func (df RotorCoolingDF) DTWall() []float64 {
+	return df.column(func(s RotorCoolingSection) float64 { return s.DTWall })
+}
+
+func (df RotorCoolingDF) column(value func(s RotorCoolingSection) float64) []float64 {
+	var result = make([]float64, len(df.Sections))
+	for i, s := range df.Sections {
+		result[i] = value(s)
+	}
+	return result
+}
@@ -0,0 +1,16 @@
+package dataframes
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRotorCoolingDF_Columns(t *testing.T) {
+	var df = RotorCoolingDF{Sections: []RotorCoolingSection{
+		{HRel: 0, TWallMax: 1100, DTWall: 150},
+		{HRel: 1, TWallMax: 1150, DTWall: 120},
+	}}
+	assert.Equal(t, []float64{0, 1}, df.HRel())
+	assert.Equal(t, []float64{1100, 1150}, df.TWallMax())
+	assert.Equal(t, []float64{150, 120}, df.DTWall())
+}
@@ -0,0 +1,66 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/turbocycle/core/graph"
+	"reflect"
+)
+
+// составные узлы, параметры которых берутся из входящих в них узлов
+var compositeAccessors = map[string]bool{
+	"GasGenerator":          true,
+	"MiddlePressureCascade": true,
+	"TurboCascade":          true,
+	"FTBlock":               true,
+	"FreeTurbineBlock":      true,
+}
+
+// MissingNodes возвращает имена методов схемы без аргументов, возвращающих узлы,
+// которые не вошли в список nodes. Составные узлы не проверяются.
+func MissingNodes(scheme interface{}, nodes []graph.Node) []string {
+	var result []string
+	for _, accessor := range nodeAccessors(scheme) {
+		if compositeAccessors[accessor.name] {
+			continue
+		}
+		if !containsNode(nodes, accessor.node) {
+			result = append(result, accessor.name)
+		}
+	}
+	return result
+}
+
+type namedNode struct {
+	name string
+	node graph.Node
+}
+
+// nodeAccessors вызывает все методы value без аргументов, возвращающие единственный узел графа
+func nodeAccessors(value interface{}) []namedNode {
+	var nodeType = reflect.TypeOf((*graph.Node)(nil)).Elem()
+	var v = reflect.ValueOf(value)
+
+	var result []namedNode
+	for i := 0; i != v.NumMethod(); i++ {
+		var method = v.Type().Method(i)
+		var methodType = v.Method(i).Type()
+		if methodType.NumIn() != 0 || methodType.NumOut() != 1 || !methodType.Out(0).Implements(nodeType) {
+			continue
+		}
+
+		var out = v.Method(i).Call(nil)[0]
+		if out.Kind() == reflect.Interface && out.IsNil() {
+			continue
+		}
+		result = append(result, namedNode{name: method.Name, node: out.Interface().(graph.Node)})
+	}
+	return result
+}
+
+func containsNode(nodes []graph.Node, node interface{}) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
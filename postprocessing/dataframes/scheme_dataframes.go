@@ -1,6 +1,9 @@
 package dataframes
 
 import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
+	"github.com/Sovianum/turbocycle/core/graph"
 	"github.com/Sovianum/turbocycle/impl/engine/nodes/constructive"
 	"github.com/Sovianum/turbocycle/library/schemes"
 	"github.com/Sovianum/turbocycle/material/gases"
@@ -9,7 +12,7 @@ import (
 func NewThreeShaftsDF(nE float64, etaR float64, scheme schemes.ThreeShaftsScheme) ThreeShaftsDF {
 	gs := scheme.GasSource()
 	pStag := gs.PressureOutput().GetState().Value().(float64)
-	tStag := gs.PressureOutput().GetState().Value().(float64)
+	tStag := gs.TemperatureOutput().GetState().Value().(float64)
 	gas := gs.GasOutput().GetState().Value().(gases.Gas)
 
 	return ThreeShaftsDF{
@@ -24,25 +27,37 @@ func NewThreeShaftsDF(nE float64, etaR float64, scheme schemes.ThreeShaftsScheme
 		LPTurbinePipe: NewPressureDropDF(scheme.LPTPipe()),
 		LPShaft:       NewShaftDF(scheme.MiddlePressureCascade().Transmission()),
 
-		HPCompressor: NewCompressorDF(scheme.HPC()),
+		HPCompressor:     NewCompressorDF(scheme.HPC()),
+		HPCompressorPipe: NewPressureDropDF(scheme.HPCPipe()),
 		HPTurbine: NewTurbineDFFromBlockedTurbine(
 			scheme.GasGenerator().TurboCascade().Turbine().(constructive.BlockedTurbineNode),
 		),
 		HPTurbinePipe: NewPressureDropDF(scheme.HPTPipe()),
-		HPShaft:       NewShaftDF(scheme.MiddlePressureCascade().Transmission()),
+		HPShaft:       NewShaftDF(scheme.GasGenerator().TurboCascade().Transmission()),
 
 		Burner:      NewBurnerDF(scheme.GasGenerator().Burner()),
 		FreeTurbine: NewTurbineDFFromFreeTurbine(scheme.FTBlock().FreeTurbine()),
 		OutletPipe:  NewPressureDropDF(scheme.FTBlock().OutletPressureLoss()),
 
-		EngineLabour: scheme.FTBlock().FreeTurbine().LSpecific(),
+		EngineLabour: units.JPerKg(scheme.FTBlock().FreeTurbine().LSpecific()),
 		Ce:           schemes.GetSpecificFuelRate(scheme),
-		MassRate:     schemes.GetMassRate(nE, scheme),
+		MassRate:     units.KgPerSecond(schemes.GetMassRate(nE, scheme)),
 		Eta:          schemes.GetEfficiency(scheme),
 		Ne:           nE,
 
 		EtaR:   etaR,
 		NeMech: nE / etaR,
+
+		nodes: []graph.Node{
+			gs, scheme.InletPressureDrop(),
+			scheme.LPC(), scheme.LPCPipe(),
+			scheme.MiddlePressureCascade().Turbine(), scheme.LPTPipe(), scheme.MiddlePressureCascade().Transmission(),
+			scheme.HPC(), scheme.HPCPipe(),
+			scheme.GasGenerator().TurboCascade().Turbine(), scheme.HPTPipe(),
+			scheme.GasGenerator().TurboCascade().Transmission(),
+			scheme.GasGenerator().Burner(),
+			scheme.FTBlock().FreeTurbine(), scheme.FTBlock().OutletPressureLoss(),
+		},
 	}
 }
 
@@ -56,22 +71,55 @@ type ThreeShaftsDF struct {
 	LPTurbinePipe    PressureDropDF `json:"lp_turbine_pipe"`
 	LPShaft          ShaftDF        `json:"lp_shaft"`
 
-	HPCompressor  CompressorDF   `json:"hp_compressor"`
-	HPTurbine     TurbineDF      `json:"hp_turbine"`
-	HPTurbinePipe PressureDropDF `json:"hp_turbine_pipe"`
-	HPShaft       ShaftDF        `json:"hp_shaft"`
+	HPCompressor     CompressorDF   `json:"hp_compressor"`
+	HPCompressorPipe PressureDropDF `json:"hp_compressor_pipe"`
+	HPTurbine        TurbineDF      `json:"hp_turbine"`
+	HPTurbinePipe    PressureDropDF `json:"hp_turbine_pipe"`
+	HPShaft          ShaftDF        `json:"hp_shaft"`
 
 	Burner BurnerDF `json:"burner"`
 
 	FreeTurbine TurbineDF      `json:"free_turbine"`
 	OutletPipe  PressureDropDF `json:"outlet_pipe"`
 
-	EngineLabour float64 `json:"engine_labour"`
-	Ce           float64 `json:"ce"`
-	MassRate     float64 `json:"mass_rate"`
-	Eta          float64 `json:"eta"`
-	Ne           float64 `json:"ne"`
+	EngineLabour units.JPerKg      `json:"engine_labour"`
+	Ce           float64           `json:"ce"`
+	MassRate     units.KgPerSecond `json:"mass_rate"`
+	Eta          float64           `json:"eta"`
+	Ne           float64           `json:"ne"`
 
 	EtaR   float64 `json:"eta_r"`
 	NeMech float64 `json:"ne_mech"`
+
+	nodes []graph.Node
+}
+
+// Nodes возвращает узлы схемы, параметры которых выведены в таблицу
+func (df ThreeShaftsDF) Nodes() []graph.Node {
+	return df.nodes
+}
+
+func (df ThreeShaftsDF) Performance() EnginePerformance {
+	return EnginePerformance{Ne: df.Ne, Eta: df.Eta, Ce: df.Ce, MassRate: df.MassRate}
+}
+
+func (df ThreeShaftsDF) Title() string {
+	return locale.T("трехвальная схема")
+}
+
+func (df ThreeShaftsDF) NodeRows() []SchemeNodeRow {
+	return numberRows([]SchemeNodeRow{
+		pressureDropNodeRow("Входное устройство", df.InletPipe),
+		compressorNodeRow("КНД", df.LPCompressor),
+		pressureDropNodeRow("Канал за КНД", df.LPCompressorPipe),
+		compressorNodeRow("КВД", df.HPCompressor),
+		pressureDropNodeRow("Канал за КВД", df.HPCompressorPipe),
+		burnerNodeRow("Камера сгорания", df.Burner),
+		turbineNodeRow("ТВД", df.HPTurbine),
+		pressureDropNodeRow("Канал за ТВД", df.HPTurbinePipe),
+		turbineNodeRow("ТНД", df.LPTurbine),
+		pressureDropNodeRow("Канал за ТНД", df.LPTurbinePipe),
+		turbineNodeRow("Свободная турбина", df.FreeTurbine),
+		pressureDropNodeRow("Выходное устройство", df.OutletPipe),
+	})
 }
@@ -57,3 +57,21 @@ func TestTemplateSmoke(t *testing.T) {
 	fileErr = templ.Execute(ioutil.Discard, &df)
 	assert.Nil(t, fileErr)
 }
+
+func TestNewThreeShaftsDF_SourceAndShafts(t *testing.T) {
+	var scheme = s3n.GetInitedThreeShaftsScheme()
+	var generator = core.GetDoubleCompressorDataGenerator(scheme, power, relaxCoef, iterNum)
+	_, err := generator(10, 0.5)
+	assert.Nil(t, err)
+
+	var df = NewThreeShaftsDF(power, 0.93, scheme)
+	assert.Equal(t, scheme.LPC().TStagIn(), float64(df.GasSource.T))
+	assert.Equal(t, scheme.GasSource().PressureOutput().GetState().Value().(float64), float64(df.GasSource.P))
+
+	// КПД валов одинаковы, поэтому валы различаются по передаваемой работе
+	var hpPower = scheme.GasGenerator().TurboCascade().Transmission().PowerOutput().GetState().Value().(float64)
+	var lpPower = scheme.MiddlePressureCascade().Transmission().PowerOutput().GetState().Value().(float64)
+	assert.NotEqual(t, hpPower, lpPower)
+	assert.Equal(t, hpPower, df.HPShaft.Power)
+	assert.Equal(t, lpPower, df.LPShaft.Power)
+}
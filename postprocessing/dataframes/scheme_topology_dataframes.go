@@ -0,0 +1,329 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
+	"github.com/Sovianum/turbocycle/core/graph"
+	"github.com/Sovianum/turbocycle/impl/engine/nodes/constructive"
+	"github.com/Sovianum/turbocycle/library/schemes"
+	"github.com/Sovianum/turbocycle/material/gases"
+)
+
+func NewTwoShaftsDF(nE float64, etaR float64, scheme schemes.TwoShaftsScheme) TwoShaftsDF {
+	gs := scheme.GasSource()
+	pStag := gs.PressureOutput().GetState().Value().(float64)
+	tStag := gs.TemperatureOutput().GetState().Value().(float64)
+	gas := gs.GasOutput().GetState().Value().(gases.Gas)
+
+	return TwoShaftsDF{
+		GasSource: NewGasDF(pStag, tStag, gas),
+		InletPipe: NewPressureDropDF(scheme.InletPressureDrop()),
+
+		Compressor: NewCompressorDF(scheme.Compressor()),
+		CompressorTurbine: NewTurbineDFFromBlockedTurbine(
+			scheme.TurboCascade().Turbine().(constructive.BlockedTurbineNode),
+		),
+		CompressorTurbinePipe: NewPressureDropDF(scheme.CompressorTurbinePipe()),
+		Shaft:                 NewShaftDF(scheme.TurboCascade().Transmission()),
+
+		Burner:      NewBurnerDF(scheme.Burner()),
+		FreeTurbine: NewTurbineDFFromFreeTurbine(scheme.FreeTurbineBlock().FreeTurbine()),
+		OutletPipe:  NewPressureDropDF(scheme.FreeTurbineBlock().OutletPressureLoss()),
+
+		EngineLabour: units.JPerKg(scheme.FreeTurbineBlock().FreeTurbine().LSpecific()),
+		Ce:           schemes.GetSpecificFuelRate(scheme),
+		MassRate:     units.KgPerSecond(schemes.GetMassRate(nE, scheme)),
+		Eta:          schemes.GetEfficiency(scheme),
+		Ne:           nE,
+
+		EtaR:   etaR,
+		NeMech: nE / etaR,
+
+		nodes: []graph.Node{
+			gs, scheme.InletPressureDrop(),
+			scheme.Compressor(),
+			scheme.TurboCascade().Turbine(), scheme.CompressorTurbinePipe(), scheme.TurboCascade().Transmission(),
+			scheme.Burner(),
+			scheme.FreeTurbineBlock().FreeTurbine(), scheme.FreeTurbineBlock().OutletPressureLoss(),
+		},
+	}
+}
+
+type TwoShaftsDF struct {
+	GasSource GasDF          `json:"gas_source"`
+	InletPipe PressureDropDF `json:"inlet_pipe"`
+
+	Compressor            CompressorDF   `json:"compressor"`
+	CompressorTurbine     TurbineDF      `json:"compressor_turbine"`
+	CompressorTurbinePipe PressureDropDF `json:"compressor_turbine_pipe"`
+	Shaft                 ShaftDF        `json:"shaft"`
+
+	Burner BurnerDF `json:"burner"`
+
+	FreeTurbine TurbineDF      `json:"free_turbine"`
+	OutletPipe  PressureDropDF `json:"outlet_pipe"`
+
+	EngineLabour units.JPerKg      `json:"engine_labour"`
+	Ce           float64           `json:"ce"`
+	MassRate     units.KgPerSecond `json:"mass_rate"`
+	Eta          float64           `json:"eta"`
+	Ne           float64           `json:"ne"`
+
+	EtaR   float64 `json:"eta_r"`
+	NeMech float64 `json:"ne_mech"`
+
+	nodes []graph.Node
+}
+
+// Nodes возвращает узлы схемы, параметры которых выведены в таблицу
+func (df TwoShaftsDF) Nodes() []graph.Node {
+	return df.nodes
+}
+
+func (df TwoShaftsDF) Title() string {
+	return locale.T("двухвальная схема")
+}
+
+func (df TwoShaftsDF) NodeRows() []SchemeNodeRow {
+	return numberRows([]SchemeNodeRow{
+		pressureDropNodeRow("Входное устройство", df.InletPipe),
+		compressorNodeRow("Компрессор", df.Compressor),
+		burnerNodeRow("Камера сгорания", df.Burner),
+		turbineNodeRow("Турбина компрессора", df.CompressorTurbine),
+		pressureDropNodeRow("Канал за турбиной компрессора", df.CompressorTurbinePipe),
+		turbineNodeRow("Свободная турбина", df.FreeTurbine),
+		pressureDropNodeRow("Выходное устройство", df.OutletPipe),
+	})
+}
+
+func NewTwoShaftsRegeneratorDF(nE float64, etaR float64, scheme schemes.TwoShaftsRegeneratorScheme) TwoShaftsRegeneratorDF {
+	var df = TwoShaftsRegeneratorDF{
+		TwoShaftsDF: NewTwoShaftsDF(nE, etaR, scheme),
+		Regenerator: NewRegeneratorNode(scheme.Regenerator()),
+	}
+	df.nodes = append(df.nodes, scheme.Regenerator())
+	return df
+}
+
+type TwoShaftsRegeneratorDF struct {
+	TwoShaftsDF
+	Regenerator RegeneratorDF `json:"regenerator"`
+}
+
+func (df TwoShaftsRegeneratorDF) Title() string {
+	return locale.T("двухвальная схема с регенератором")
+}
+
+func (df TwoShaftsRegeneratorDF) NodeRows() []SchemeNodeRow {
+	return numberRows(append(df.TwoShaftsDF.NodeRows(), regeneratorNodeRows(df.Regenerator)...))
+}
+
+func NewThreeShaftsRegeneratorDF(nE float64, etaR float64, scheme schemes.ThreeShaftsRegeneratorScheme) ThreeShaftsRegeneratorDF {
+	var df = ThreeShaftsRegeneratorDF{
+		ThreeShaftsDF: NewThreeShaftsDF(nE, etaR, scheme),
+		Regenerator:   NewRegeneratorNode(scheme.Regenerator()),
+	}
+	df.nodes = append(df.nodes, scheme.Regenerator())
+	return df
+}
+
+type ThreeShaftsRegeneratorDF struct {
+	ThreeShaftsDF
+	Regenerator RegeneratorDF `json:"regenerator"`
+}
+
+func (df ThreeShaftsRegeneratorDF) Title() string {
+	return locale.T("трехвальная схема с регенератором")
+}
+
+func (df ThreeShaftsRegeneratorDF) NodeRows() []SchemeNodeRow {
+	return numberRows(append(df.ThreeShaftsDF.NodeRows(), regeneratorNodeRows(df.Regenerator)...))
+}
+
+func NewThreeShaftsCoolerDF(nE float64, etaR float64, scheme schemes.ThreeShaftsCoolerScheme) ThreeShaftsCoolerDF {
+	var df = ThreeShaftsCoolerDF{
+		ThreeShaftsDF: NewThreeShaftsDF(nE, etaR, scheme),
+		Cooler:        NewCoolerDF(scheme.Cooler()),
+	}
+	df.nodes = append(df.nodes, scheme.Cooler())
+	return df
+}
+
+type ThreeShaftsCoolerDF struct {
+	ThreeShaftsDF
+	Cooler CoolerDF `json:"cooler"`
+}
+
+func (df ThreeShaftsCoolerDF) Title() string {
+	return locale.T("трехвальная схема с промежуточным охлаждением")
+}
+
+func (df ThreeShaftsCoolerDF) NodeRows() []SchemeNodeRow {
+	return numberRows(append(df.ThreeShaftsDF.NodeRows(), coolerNodeRow("Промежуточный охладитель", df.Cooler)))
+}
+
+func NewThreeShaftsCoolingRegeneratorDF(
+	nE float64, etaR float64, scheme schemes.ThreeShaftsCoolingRegeneratorScheme,
+) ThreeShaftsCoolingRegeneratorDF {
+	var df = ThreeShaftsCoolingRegeneratorDF{
+		ThreeShaftsDF: NewThreeShaftsDF(nE, etaR, scheme),
+		Cooler:        NewCoolerDF(scheme.Cooler()),
+		Regenerator:   NewRegeneratorNode(scheme.Regenerator()),
+	}
+	df.nodes = append(df.nodes, scheme.Cooler(), scheme.Regenerator())
+	return df
+}
+
+type ThreeShaftsCoolingRegeneratorDF struct {
+	ThreeShaftsDF
+	Cooler      CoolerDF      `json:"cooler"`
+	Regenerator RegeneratorDF `json:"regenerator"`
+}
+
+func (df ThreeShaftsCoolingRegeneratorDF) Title() string {
+	return locale.T("трехвальная схема с промежуточным охлаждением и регенератором")
+}
+
+func (df ThreeShaftsCoolingRegeneratorDF) NodeRows() []SchemeNodeRow {
+	var rows = append(df.ThreeShaftsDF.NodeRows(), coolerNodeRow("Промежуточный охладитель", df.Cooler))
+	return numberRows(append(rows, regeneratorNodeRows(df.Regenerator)...))
+}
+
+func NewThreeShaftsBurnDF(nE float64, etaR float64, scheme schemes.ThreeShaftsBurnScheme) ThreeShaftsBurnDF {
+	var df = ThreeShaftsBurnDF{
+		ThreeShaftsDF: NewThreeShaftsDF(nE, etaR, scheme),
+		MidBurner:     NewBurnerDF(scheme.MidBurner()),
+	}
+	df.nodes = append(df.nodes, scheme.MidBurner())
+	return df
+}
+
+type ThreeShaftsBurnDF struct {
+	ThreeShaftsDF
+	MidBurner BurnerDF `json:"mid_burner"`
+}
+
+func (df ThreeShaftsBurnDF) Title() string {
+	return locale.T("трехвальная схема с промежуточным подогревом")
+}
+
+func (df ThreeShaftsBurnDF) NodeRows() []SchemeNodeRow {
+	return numberRows(append(df.ThreeShaftsDF.NodeRows(), burnerNodeRow("Промежуточная камера сгорания", df.MidBurner)))
+}
+
+// SubCompressHiddenNodes - узлы схемы с дожимающим компрессором, которые не выводятся в таблицу:
+// параметры делителя и смесителя потока совпадают с параметрами на входе в дожимающий компрессор
+// и на выходе из охладителя
+var SubCompressHiddenNodes = []string{"GasSplitter", "GasCombiner"}
+
+// NewThreeShaftsSubCompressDF не выводит делитель и смеситель потока отдельно (см. SubCompressHiddenNodes)
+func NewThreeShaftsSubCompressDF(nE float64, etaR float64, scheme schemes.ThreeShaftsSubCompressScheme) ThreeShaftsSubCompressDF {
+	var df = ThreeShaftsSubCompressDF{
+		ThreeShaftsDF: NewThreeShaftsDF(nE, etaR, scheme),
+		SubCompressor: NewCompressorDF(scheme.SubCompressor()),
+		SubCooler:     NewCoolerDF(scheme.SubCooler()),
+	}
+	df.nodes = append(df.nodes, scheme.SubCompressor(), scheme.SubCooler())
+	return df
+}
+
+type ThreeShaftsSubCompressDF struct {
+	ThreeShaftsDF
+	SubCompressor CompressorDF `json:"sub_compressor"`
+	SubCooler     CoolerDF     `json:"sub_cooler"`
+}
+
+func (df ThreeShaftsSubCompressDF) Title() string {
+	return locale.T("трехвальная схема с дожимающим компрессором")
+}
+
+func (df ThreeShaftsSubCompressDF) NodeRows() []SchemeNodeRow {
+	return numberRows(append(
+		df.ThreeShaftsDF.NodeRows(),
+		compressorNodeRow("Дожимающий компрессор", df.SubCompressor),
+		coolerNodeRow("Охладитель", df.SubCooler),
+	))
+}
+
+// SchemeSummary - схема любой топологии, параметры узлов которой выводятся в сводную таблицу
+type SchemeSummary interface {
+	Title() string
+	NodeRows() []SchemeNodeRow
+	Nodes() []graph.Node
+	Performance() EnginePerformance
+}
+
+// EnginePerformance - основные показатели двигателя
+type EnginePerformance struct {
+	Ne       float64
+	Eta      float64
+	Ce       float64
+	MassRate units.KgPerSecond
+}
+
+func NewSchemeSummaryDF(scheme SchemeSummary) SchemeSummaryDF {
+	return SchemeSummaryDF{
+		Title:             scheme.Title(),
+		NodeRows:          scheme.NodeRows(),
+		EnginePerformance: scheme.Performance(),
+	}
+}
+
+// SchemeSummaryDF - данные сводной таблицы одной схемы
+type SchemeSummaryDF struct {
+	Title    string
+	NodeRows []SchemeNodeRow
+	EnginePerformance
+}
+
+func (df TwoShaftsDF) Performance() EnginePerformance {
+	return EnginePerformance{Ne: df.Ne, Eta: df.Eta, Ce: df.Ce, MassRate: df.MassRate}
+}
+
+// SchemeNodeRow - строка сводной таблицы параметров узлов схемы
+type SchemeNodeRow struct {
+	Id   int
+	Name string
+	PIn  units.Pascal
+	POut units.Pascal
+	TIn  units.Kelvin
+	TOut units.Kelvin
+}
+
+func numberRows(rows []SchemeNodeRow) []SchemeNodeRow {
+	for i := range rows {
+		rows[i].Id = i + 1
+	}
+	return rows
+}
+
+func compressorNodeRow(name string, df CompressorDF) SchemeNodeRow {
+	return SchemeNodeRow{Name: locale.T(name), PIn: df.PIn, POut: df.POut, TIn: df.TIn, TOut: df.TOut}
+}
+
+func turbineNodeRow(name string, df TurbineDF) SchemeNodeRow {
+	return SchemeNodeRow{Name: locale.T(name), PIn: df.PIn, POut: df.POut, TIn: df.TIn, TOut: df.TOut}
+}
+
+func pressureDropNodeRow(name string, df PressureDropDF) SchemeNodeRow {
+	return SchemeNodeRow{Name: locale.T(name), PIn: df.PIn, POut: df.POut, TIn: df.TIn, TOut: df.TOut}
+}
+
+func coolerNodeRow(name string, df CoolerDF) SchemeNodeRow {
+	return SchemeNodeRow{Name: locale.T(name), PIn: df.PIn, POut: df.POut, TIn: df.TIn, TOut: df.TOut}
+}
+
+func burnerNodeRow(name string, df BurnerDF) SchemeNodeRow {
+	return SchemeNodeRow{
+		Name: locale.T(name),
+		PIn:  df.AirDataInlet.P, POut: df.GasDataOutlet.P,
+		TIn: df.AirDataInlet.T, TOut: df.GasDataOutlet.T,
+	}
+}
+
+func regeneratorNodeRows(df RegeneratorDF) []SchemeNodeRow {
+	return []SchemeNodeRow{
+		{Name: locale.T("Регенератор (воздух)"), PIn: df.PColdIn, POut: df.PColdOut, TIn: df.TColdIn, TOut: df.TColdOut},
+		{Name: locale.T("Регенератор (газ)"), PIn: df.PHotIn, POut: df.PHotOut, TIn: df.THotIn, TOut: df.THotOut},
+	}
+}
@@ -0,0 +1,90 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/core"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s2n"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s2nr"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nb"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nc"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nr"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nrc"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nsc"
+	templ2 "github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"testing"
+)
+
+const (
+	schemeSummaryTemplateFilePath = "../templates/scheme_summary_template.tex"
+
+	singlePi = 8.
+	doublePi = 10.
+	piFactor = 0.5
+)
+
+func TestSchemeDFs_NodeRows(t *testing.T) {
+	var s2nScheme = s2n.GetInitedTwoShaftsScheme()
+	solveSingle(t, s2nScheme)
+	var s2nrScheme = s2nr.GetInitedTwoShaftsRegeneratorScheme()
+	solveSingle(t, s2nrScheme)
+	var s3nrScheme = s3nr.GetInitedThreeShaftsRegeneratorScheme()
+	solveDouble(t, s3nrScheme)
+	var s3ncScheme = s3nc.GetInitedThreeShaftsCoolingScheme()
+	solveDouble(t, s3ncScheme)
+	var s3nrcScheme = s3nrc.GetInitedThreeShaftsCoolRegeneratorScheme()
+	solveDouble(t, s3nrcScheme)
+	var s3nbScheme = s3nb.GetInitedThreeShaftsBurnScheme()
+	solveDouble(t, s3nbScheme)
+	var s3nscScheme = s3nsc.GetInitedThreeShaftsSubCompressScheme()
+	solveDouble(t, s3nscScheme)
+
+	var testCases = []struct {
+		name   string
+		scheme interface{}
+		df     SchemeSummary
+		hidden []string
+	}{
+		{"s2n", s2nScheme, NewTwoShaftsDF(power, 0.93, s2nScheme), nil},
+		{"s2nr", s2nrScheme, NewTwoShaftsRegeneratorDF(power, 0.93, s2nrScheme), nil},
+		{"s3nr", s3nrScheme, NewThreeShaftsRegeneratorDF(power, 0.93, s3nrScheme), nil},
+		{"s3nc", s3ncScheme, NewThreeShaftsCoolerDF(power, 0.93, s3ncScheme), nil},
+		{"s3nrc", s3nrcScheme, NewThreeShaftsCoolingRegeneratorDF(power, 0.93, s3nrcScheme), nil},
+		{"s3nb", s3nbScheme, NewThreeShaftsBurnDF(power, 0.93, s3nbScheme), nil},
+		{"s3nsc", s3nscScheme, NewThreeShaftsSubCompressDF(power, 0.93, s3nscScheme), SubCompressHiddenNodes},
+	}
+
+	var f, fileErr = ioutil.ReadFile(schemeSummaryTemplateFilePath)
+	assert.Nil(t, fileErr)
+	var templ, tErr = templ2.GetTemplate("scheme_summary", string(f), templ2.GetFuncMap())
+	assert.Nil(t, tErr)
+
+	var summaries []SchemeSummaryDF
+	for _, tc := range testCases {
+		assert.NotEmpty(t, tc.df.Title(), tc.name)
+		assert.ElementsMatch(t, tc.hidden, MissingNodes(tc.scheme, tc.df.Nodes()), tc.name)
+
+		for i, row := range tc.df.NodeRows() {
+			assert.Equal(t, i+1, row.Id, tc.name)
+			assert.True(t, row.PIn > 0 && row.TIn > 0, "%s: %s", tc.name, row.Name)
+		}
+		var summary = NewSchemeSummaryDF(tc.df)
+		assert.Equal(t, tc.df.Title(), summary.Title, tc.name)
+		assert.Equal(t, power, summary.Ne, tc.name)
+		summaries = append(summaries, summary)
+	}
+
+	assert.Nil(t, templ.Execute(ioutil.Discard, summaries))
+}
+
+func solveSingle(t *testing.T, scheme core.SingleCompressorScheme) {
+	var generator = core.GetSingleCompressorDataGenerator(scheme, power, relaxCoef, iterNum)
+	var _, err = generator(singlePi)
+	assert.Nil(t, err)
+}
+
+func solveDouble(t *testing.T, scheme core.DoubleCompressorScheme) {
+	var generator = core.GetDoubleCompressorDataGenerator(scheme, power, relaxCoef, iterNum)
+	var _, err = generator(doublePi, piFactor)
+	assert.Nil(t, err)
+}
@@ -1,6 +1,10 @@
 package dataframes
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"strings"
+)
 
 func NewTurbineStageRow(name, dimension string, dfs []TurbineStageDF, extractor func(df TurbineStageDF) float64) StageRow {
 	result := StageRow{Name: name, Dimension: dimension, Values: make([]float64, len(dfs))}
@@ -41,19 +45,22 @@ func (row StageRow) FormatString(f func(float64) string) StageRow {
 	return row
 }
 
+// GetStr возвращает строку таблицы LaTeX; обозначение и размерность выводятся на текущем языке
 func (row StageRow) GetStr() string {
+	return fmt.Sprintf("%d & %s & %s & ", row.ID, locale.T(row.Name), locale.T(row.Dimension)) + strings.Join(row.Strings(), " & ")
+}
+
+// Strings возвращает отформатированные значения по ступеням
+func (row StageRow) Strings() []string {
 	if row.stringFormatter == nil {
 		row.stringFormatter = func(f float64) string {
 			return fmt.Sprintf("%f", f)
 		}
 	}
-
-	result := fmt.Sprintf("%d & %s & %s &", row.ID, row.Name, row.Dimension)
-	for _, v := range row.Values[:len(row.Values)-1] {
-		result += fmt.Sprintf(" %s &", row.getFormatFloat(v))
+	var result = make([]string, len(row.Values))
+	for i, v := range row.Values {
+		result[i] = row.getFormatFloat(v)
 	}
-	last := fmt.Sprintf(" %s", row.getFormatFloat(row.Values[len(row.Values)-1]))
-	result += last
 	return result
 }
 
@@ -0,0 +1,17 @@
+package dataframes
+
+import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStageRow_GetStr_English(t *testing.T) {
+	defer locale.Set(locale.Current())
+
+	var row = StageRow{ID: 1, Name: "$H_т$", Dimension: "$м/с$", Values: []float64{1.5, 2}}.FormatString(Round1)
+	assert.Equal(t, "1 & $H_т$ & $м/с$ & 1,5 & 2,0", row.GetStr())
+
+	locale.Set(locale.English)
+	assert.Equal(t, "1 & $H_t$ & $m/s$ & 1.5 & 2.0", row.GetStr())
+}
@@ -1,6 +1,7 @@
 package dataframes
 
 import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
 	"github.com/Sovianum/turbocycle/common"
 	states2 "github.com/Sovianum/turbocycle/impl/engine/states"
 	"github.com/Sovianum/turbocycle/impl/stage/geometry"
@@ -253,8 +254,8 @@ func NewTurbineBladingGeometryDF(relGen turbine.BladingGeometryGenerator, geom g
 		LRelOut:    relGen.LRelOut(),
 		Elongation: relGen.Elongation(),
 		DeltaRel:   relGen.DeltaRel(),
-		GammaIn:    relGen.GammaIn(),
-		GammaOut:   relGen.GammaOut(),
+		GammaIn:    units.Radian(relGen.GammaIn()),
+		GammaOut:   units.Radian(relGen.GammaOut()),
 		AreaOut:    geometry.Area(geom.XBladeOut(), geom),
 		DMeanOut:   geom.MeanProfile().Diameter(geom.XBladeOut()),
 		DMeanIn:    geom.MeanProfile().Diameter(geom.XBladeOut()),
@@ -263,13 +264,13 @@ func NewTurbineBladingGeometryDF(relGen turbine.BladingGeometryGenerator, geom g
 }
 
 type TurbineBladingGeometryDF struct {
-	LRelOut    float64 `json:"l_rel_out"`
-	Elongation float64 `json:"elongation"`
-	DeltaRel   float64 `json:"delta_rel"`
-	GammaIn    float64 `json:"gamma_in"`
-	GammaOut   float64 `json:"gamma_out"`
-	AreaOut    float64 `json:"area_out"`
-	DMeanOut   float64 `json:"d_mean_out"`
-	DMeanIn    float64 `json:"d_mean_in"`
-	LOut       float64 `json:"l_out"`
+	LRelOut    float64      `json:"l_rel_out"`
+	Elongation float64      `json:"elongation"`
+	DeltaRel   float64      `json:"delta_rel"`
+	GammaIn    units.Radian `json:"gamma_in"`
+	GammaOut   units.Radian `json:"gamma_out"`
+	AreaOut    float64      `json:"area_out"`
+	DMeanOut   float64      `json:"d_mean_out"`
+	DMeanIn    float64      `json:"d_mean_in"`
+	LOut       float64      `json:"l_out"`
 }
@@ -0,0 +1,101 @@
+package drawing
+
+import (
+	"math"
+)
+
+const (
+	arrowRel = 0.04 // длина стрелки относительно размера треугольника скоростей
+	textRel  = 0.03 // высота надписи относительно характерного размера чертежа
+)
+
+// Triangle - треугольник скоростей в проекциях: осевая и окружная составляющие
+// абсолютной скорости и окружная скорость
+type Triangle struct {
+	CA float64
+	CU float64
+	U  float64
+}
+
+// BladeRow - венец в меридиональном сечении: осевое положение и диаметры
+// втулки и периферии на входе и выходе
+type BladeRow struct {
+	Name    string
+	X       float64
+	Length  float64
+	DHubIn  float64
+	DHubOut float64
+	DTipIn  float64
+	DTipOut float64
+}
+
+func NewProfileDrawing(coordinates [][]float64) *Drawing {
+	var d = new(Drawing)
+	d.AddPolyline(ProfileLayer, coordinates, true)
+	return d
+}
+
+// NewCascadeDrawing строит решетку из нескольких профилей (например, двух соседних на шаге)
+func NewCascadeDrawing(coordinatesArr [][][]float64) *Drawing {
+	var d = new(Drawing)
+	for _, coordinates := range coordinatesArr {
+		d.AddPolyline(ProfileLayer, coordinates, true)
+	}
+	return d
+}
+
+// NewTrianglesDrawing строит входной и выходной треугольники скоростей с общей вершиной.
+// Окружное направление - ось x, осевое - ось -y.
+func NewTrianglesDrawing(inlet, outlet Triangle) *Drawing {
+	var d = new(Drawing)
+	var size = math.Max(
+		math.Max(math.Hypot(inlet.CA, inlet.CU), math.Hypot(outlet.CA, outlet.CU)),
+		math.Max(math.Abs(inlet.U), math.Abs(outlet.U)),
+	)
+	var arrow = size * arrowRel
+
+	for _, item := range []struct {
+		triangle Triangle
+		suffix   string
+	}{{inlet, "1"}, {outlet, "2"}} {
+		var c = []float64{item.triangle.CU, -item.triangle.CA}
+		var w = []float64{item.triangle.CU - item.triangle.U, -item.triangle.CA}
+
+		addArrow(d, 0, 0, c[0], c[1], arrow)
+		addArrow(d, 0, 0, w[0], w[1], arrow)
+		addArrow(d, w[0], w[1], c[0], c[1], arrow)
+
+		d.AddLabel(TextLayer, c[0]/2, c[1]/2, size*textRel, "c"+item.suffix)
+		d.AddLabel(TextLayer, w[0]/2, w[1]/2, size*textRel, "w"+item.suffix)
+		d.AddLabel(TextLayer, (w[0]+c[0])/2, c[1], size*textRel, "u"+item.suffix)
+	}
+	return d
+}
+
+func addRows(d *Drawing, rows []BladeRow) {
+	for _, row := range rows {
+		var x0, x1 = row.X, row.X + row.Length
+		d.AddPolyline(BladeLayer, [][]float64{
+			{x0, row.DHubIn / 2}, {x1, row.DHubOut / 2}, {x1, row.DTipOut / 2}, {x0, row.DTipIn / 2},
+		}, true)
+		if row.Name != "" {
+			var height = (row.DTipIn - row.DHubIn) / 2
+			d.AddLabel(TextLayer, x0, (row.DTipIn/2)+height*0.1, height*textRel*3, row.Name)
+		}
+	}
+}
+
+func addArrow(d *Drawing, x1, y1, x2, y2, size float64) {
+	d.AddLine(VelocityLayer, x1, y1, x2, y2)
+	var l = math.Hypot(x2-x1, y2-y1)
+	if l == 0 {
+		return
+	}
+	var ex, ey = (x2 - x1) / l, (y2 - y1) / l
+	var nx, ny = -ey, ex
+	d.AddPolyline(VelocityLayer, [][]float64{
+		{x2 - size*ex + size/3*nx, y2 - size*ey + size/3*ny},
+		{x2, y2},
+		{x2 - size*ex - size/3*nx, y2 - size*ey - size/3*ny},
+	}, false)
+}
@@ -0,0 +1,85 @@
+package drawing
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	ProfileLayer  = "PROFILE"
+	HubLayer      = "HUB"
+	TipLayer      = "TIP"
+	BladeLayer    = "BLADE"
+	AxisLayer     = "AXIS"
+	VelocityLayer = "VELOCITY"
+	TextLayer     = "TEXT"
+)
+
+type Polyline struct {
+	Layer  string
+	Points [][]float64
+	Closed bool
+}
+
+type Label struct {
+	Layer  string
+	X      float64
+	Y      float64
+	Height float64
+	Text   string
+}
+
+// Drawing - набор плоских примитивов в единицах модели (м или м/с), общий для SVG и DXF
+type Drawing struct {
+	Polylines []Polyline
+	Labels    []Label
+}
+
+func (d *Drawing) AddPolyline(layer string, points [][]float64, closed bool) {
+	d.Polylines = append(d.Polylines, Polyline{Layer: layer, Points: points, Closed: closed})
+}
+
+func (d *Drawing) AddLine(layer string, x1, y1, x2, y2 float64) {
+	d.AddPolyline(layer, [][]float64{{x1, y1}, {x2, y2}}, false)
+}
+
+func (d *Drawing) AddLabel(layer string, x, y, height float64, text string) {
+	d.Labels = append(d.Labels, Label{Layer: layer, X: x, Y: y, Height: height, Text: text})
+}
+
+func (d *Drawing) Layers() []string {
+	var set = make(map[string]bool)
+	for _, p := range d.Polylines {
+		set[p.Layer] = true
+	}
+	for _, l := range d.Labels {
+		set[l.Layer] = true
+	}
+	var result = make([]string, 0, len(set))
+	for layer := range set {
+		result = append(result, layer)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (d *Drawing) Bounds() (xMin, yMin, xMax, yMax float64) {
+	xMin, yMin = math.Inf(1), math.Inf(1)
+	xMax, yMax = math.Inf(-1), math.Inf(-1)
+	var update = func(x, y float64) {
+		xMin, xMax = math.Min(xMin, x), math.Max(xMax, x)
+		yMin, yMax = math.Min(yMin, y), math.Max(yMax, y)
+	}
+	for _, p := range d.Polylines {
+		for _, point := range p.Points {
+			update(point[0], point[1])
+		}
+	}
+	for _, l := range d.Labels {
+		update(l.X, l.Y)
+	}
+	if math.IsInf(xMin, 1) {
+		return 0, 0, 0, 0
+	}
+	return
+}
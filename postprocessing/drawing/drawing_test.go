@@ -0,0 +1,62 @@
+package drawing
+
+import (
+	"bytes"
+	"github.com/Sovianum/cooling-course-project/core/flowpath"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestNewFlowPathDrawing(t *testing.T) {
+	var d, err = NewFlowPathDrawing(flowpath.FlowPath{Segments: []flowpath.Segment{
+		{Name: "СА1", Kind: flowpath.RowSegment, X0: 0, X1: 0.04, DHubIn: 0.5, DHubOut: 0.5, DTipIn: 0.7, DTipOut: 0.72},
+		{Kind: flowpath.GapSegment, X0: 0.04, X1: 0.05, DHubIn: 0.5, DHubOut: 0.5, DTipIn: 0.72, DTipOut: 0.72},
+		{Name: "РК1", Kind: flowpath.RowSegment, X0: 0.05, X1: 0.09, DHubIn: 0.5, DHubOut: 0.5, DTipIn: 0.72, DTipOut: 0.75},
+		{Kind: flowpath.GapSegment, X0: 0.09, X1: 0.1, DHubIn: 0.5, DHubOut: 0.5, DTipIn: 0.75, DTipOut: 0.75},
+	}})
+	assert.NoError(t, err)
+	assert.Len(t, d.Polylines, 5)
+
+	var xMin, yMin, xMax, yMax = d.Bounds()
+	assert.InDelta(t, 0, xMin, 1e-12)
+	assert.InDelta(t, 0, yMin, 1e-12)
+	assert.InDelta(t, 0.1, xMax, 1e-12)
+	assert.True(t, yMax >= 0.375)
+
+	_, err = NewFlowPathDrawing(flowpath.FlowPath{})
+	assert.Error(t, err)
+}
+
+func TestWriters(t *testing.T) {
+	var d = NewTrianglesDrawing(Triangle{CA: 150, CU: 300, U: 350}, Triangle{CA: 160, CU: -20, U: 350})
+	d.AddLabel(TextLayer, 0, 0, 10, "a<b")
+
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteSVG(buf, d))
+	var svg = buf.String()
+	assert.True(t, strings.HasPrefix(svg, "<svg"))
+	assert.Contains(t, svg, "a&lt;b")
+	assert.Equal(t, strings.Count(svg, "<g "), len(d.Layers()))
+
+	buf.Reset()
+	assert.NoError(t, WriteDXF(buf, d))
+	var dxf = buf.String()
+	assert.Equal(t, len(d.Polylines), strings.Count(dxf, "\nPOLYLINE\n"))
+	assert.Equal(t, len(d.Polylines), strings.Count(dxf, "\nSEQEND\n"))
+	assert.True(t, strings.HasSuffix(dxf, "0\nEOF\n"))
+	assert.True(t, strings.HasPrefix(dxf, "0\nSECTION\n2\nHEADER\n9\n$ACADVER\n1\nAC1009\n9\n$DWGCODEPAGE\n3\nANSI_1251\n"))
+}
+
+func TestWriteDXF_Encoding(t *testing.T) {
+	var d = new(Drawing)
+	d.AddLine("Оси", 0, 0, 1, 0)
+	d.AddLabel(TextLayer, 0, 0, 1, "СА1")
+
+	var buf = new(bytes.Buffer)
+	assert.NoError(t, WriteDXF(buf, d))
+	var dxf = buf.String()
+	assert.Contains(t, dxf, "\n8\nOSI\n")
+	assert.NotContains(t, dxf, "Оси")
+	assert.Contains(t, dxf, "\n1\n\xd1\xc01\n")
+}
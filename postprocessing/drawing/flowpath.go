@@ -0,0 +1,38 @@
+package drawing
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/flowpath"
+)
+
+// NewFlowPathDrawing строит меридиональное сечение по собранной проточной части:
+// венцы изображаются контурами, зазоры и переходные каналы - линиями втулки и периферии
+func NewFlowPathDrawing(fp flowpath.FlowPath) (*Drawing, error) {
+	if len(fp.Segments) == 0 {
+		return nil, fmt.Errorf("empty flow path")
+	}
+	var rows []BladeRow
+	for _, s := range fp.Segments {
+		if s.Kind != flowpath.RowSegment {
+			continue
+		}
+		rows = append(rows, BladeRow{
+			Name:    s.Name,
+			X:       s.X0,
+			Length:  s.Length(),
+			DHubIn:  s.DHubIn,
+			DHubOut: s.DHubOut,
+			DTipIn:  s.DTipIn,
+			DTipOut: s.DTipOut,
+		})
+	}
+
+	var d = new(Drawing)
+	addRows(d, rows)
+	d.AddPolyline(HubLayer, fp.HubLine(), false)
+	d.AddPolyline(TipLayer, fp.CasingLine(), false)
+
+	var last = fp.Segments[len(fp.Segments)-1]
+	d.AddLine(AxisLayer, fp.Segments[0].X0, 0, last.X1, 0)
+	return d, nil
+}
@@ -0,0 +1,14 @@
+package drawing
+
+import (
+	"github.com/Sovianum/turbocycle/impl/stage/states"
+	"math"
+)
+
+func NewTriangle(triangle states.VelocityTriangle) Triangle {
+	return Triangle{
+		CA: triangle.CA(),
+		CU: triangle.C() * math.Cos(triangle.Alpha()),
+		U:  triangle.U(),
+	}
+}
@@ -0,0 +1,240 @@
+package drawing
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+const (
+	dxfVersion  = "AC1009" // R12
+	dxfCodePage = "ANSI_1251"
+
+	svgWidth       = 800.
+	svgMarginRel   = 0.05
+	svgStrokeWidth = 1.5
+)
+
+var layerColors = map[string]string{
+	ProfileLayer:  "black",
+	HubLayer:      "blue",
+	TipLayer:      "blue",
+	BladeLayer:    "black",
+	AxisLayer:     "gray",
+	VelocityLayer: "red",
+	TextLayer:     "black",
+}
+
+// номера цветов AutoCAD (ACI) для слоев DXF
+var layerACI = map[string]int{
+	ProfileLayer:  7,
+	HubLayer:      5,
+	TipLayer:      5,
+	BladeLayer:    7,
+	AxisLayer:     8,
+	VelocityLayer: 1,
+	TextLayer:     7,
+}
+
+func SaveSVG(path string, d *Drawing) error {
+	return saveDrawing(path, d, WriteSVG)
+}
+
+func SaveDXF(path string, d *Drawing) error {
+	return saveDrawing(path, d, WriteDXF)
+}
+
+// WriteSVG записывает чертеж в SVG. Ось y направлена вверх, как в модели.
+func WriteSVG(w io.Writer, d *Drawing) error {
+	var xMin, yMin, xMax, yMax = d.Bounds()
+	var size = math.Max(xMax-xMin, yMax-yMin)
+	if size == 0 {
+		size = 1
+	}
+	var margin = size * svgMarginRel
+	xMin, yMin, xMax, yMax = xMin-margin, yMin-margin, xMax+margin, yMax+margin
+	var scale = svgWidth / (xMax - xMin)
+	var height = (yMax - yMin) * scale
+
+	var tx = func(x float64) float64 { return (x - xMin) * scale }
+	var ty = func(y float64) float64 { return (yMax - y) * scale }
+
+	var bw = bufio.NewWriter(w)
+	fmt.Fprintf(
+		bw, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%.1f\" height=\"%.1f\" viewBox=\"0 0 %.1f %.1f\">\n",
+		svgWidth, height, svgWidth, height,
+	)
+	for _, layer := range d.Layers() {
+		fmt.Fprintf(bw, "<g id=\"%s\" stroke=\"%s\" fill=\"none\" stroke-width=\"%.1f\">\n",
+			layer, layerColor(layer), svgStrokeWidth,
+		)
+		for _, p := range d.Polylines {
+			if p.Layer != layer {
+				continue
+			}
+			var coords = make([]string, len(p.Points))
+			for i, point := range p.Points {
+				coords[i] = fmt.Sprintf("%.3f,%.3f", tx(point[0]), ty(point[1]))
+			}
+			var tag = "polyline"
+			if p.Closed {
+				tag = "polygon"
+			}
+			fmt.Fprintf(bw, "<%s points=\"%s\"/>\n", tag, strings.Join(coords, " "))
+		}
+		for _, l := range d.Labels {
+			if l.Layer != layer {
+				continue
+			}
+			fmt.Fprintf(bw, "<text x=\"%.3f\" y=\"%.3f\" font-size=\"%.1f\" stroke=\"none\" fill=\"%s\">%s</text>\n",
+				tx(l.X), ty(l.Y), l.Height*scale, layerColor(layer), escapeXML(l.Text),
+			)
+		}
+		fmt.Fprintln(bw, "</g>")
+	}
+	fmt.Fprintln(bw, "</svg>")
+	return bw.Flush()
+}
+
+// WriteDXF записывает чертеж в формате DXF R12 (ASCII), читаемом большинством CAD-систем.
+// Надписи записываются в кодировке Windows-1251, указанной в заголовке; имена слоев - только латиницей.
+func WriteDXF(w io.Writer, d *Drawing) error {
+	var bw = bufio.NewWriter(w)
+	var pair = func(code int, value interface{}) {
+		fmt.Fprintf(bw, "%d\n%v\n", code, value)
+	}
+	var point = func(x, y float64) {
+		pair(10, fmt.Sprintf("%f", x))
+		pair(20, fmt.Sprintf("%f", y))
+		pair(30, "0.0")
+	}
+
+	var layers = d.Layers()
+	pair(0, "SECTION")
+	pair(2, "HEADER")
+	pair(9, "$ACADVER")
+	pair(1, dxfVersion)
+	pair(9, "$DWGCODEPAGE")
+	pair(3, dxfCodePage)
+	pair(0, "ENDSEC")
+
+	pair(0, "SECTION")
+	pair(2, "TABLES")
+	pair(0, "TABLE")
+	pair(2, "LAYER")
+	pair(70, len(layers))
+	for _, layer := range layers {
+		pair(0, "LAYER")
+		pair(2, dxfLayerName(layer))
+		pair(70, 0)
+		pair(62, layerACIColor(layer))
+		pair(6, "CONTINUOUS")
+	}
+	pair(0, "ENDTAB")
+	pair(0, "ENDSEC")
+
+	pair(0, "SECTION")
+	pair(2, "ENTITIES")
+	for _, p := range d.Polylines {
+		var layer = dxfLayerName(p.Layer)
+		pair(0, "POLYLINE")
+		pair(8, layer)
+		pair(66, 1)
+		if p.Closed {
+			pair(70, 1)
+		} else {
+			pair(70, 0)
+		}
+		point(0, 0)
+		for _, v := range p.Points {
+			pair(0, "VERTEX")
+			pair(8, layer)
+			point(v[0], v[1])
+		}
+		pair(0, "SEQEND")
+		pair(8, layer)
+	}
+	for _, l := range d.Labels {
+		pair(0, "TEXT")
+		pair(8, dxfLayerName(l.Layer))
+		point(l.X, l.Y)
+		pair(40, fmt.Sprintf("%f", l.Height))
+		pair(1, encodeCP1251(l.Text))
+	}
+	pair(0, "ENDSEC")
+	pair(0, "EOF")
+	return bw.Flush()
+}
+
+// dxfLayerName транслитерирует кириллицу и заменяет прочие символы, недопустимые в именах слоев R12
+func dxfLayerName(layer string) string {
+	var result = make([]rune, 0, len(layer))
+	for _, r := range strings.ToUpper(layer) {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-', r == '$':
+			result = append(result, r)
+		case translit[r] != "":
+			result = append(result, []rune(translit[r])...)
+		default:
+			result = append(result, '_')
+		}
+	}
+	return string(result)
+}
+
+var translit = map[rune]string{
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ё': "E", 'Ж': "ZH", 'З': "Z", 'И': "I",
+	'Й': "Y", 'К': "K", 'Л': "L", 'М': "M", 'Н': "N", 'О': "O", 'П': "P", 'Р': "R", 'С': "S", 'Т': "T",
+	'У': "U", 'Ф': "F", 'Х': "KH", 'Ц': "TS", 'Ч': "CH", 'Ш': "SH", 'Щ': "SHCH", 'Ъ': "", 'Ы': "Y", 'Ь': "",
+	'Э': "E", 'Ю': "YU", 'Я': "YA",
+}
+
+// encodeCP1251 переводит строку в кодировку Windows-1251; символы вне кодировки заменяются на '?'
+func encodeCP1251(s string) string {
+	var result = make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r < 0x80:
+			result = append(result, byte(r))
+		case r >= 'А' && r <= 'я':
+			result = append(result, byte(r-'А'+0xC0))
+		case r == 'Ё':
+			result = append(result, 0xA8)
+		case r == 'ё':
+			result = append(result, 0xB8)
+		default:
+			result = append(result, '?')
+		}
+	}
+	return string(result)
+}
+
+func layerColor(layer string) string {
+	if color, ok := layerColors[layer]; ok {
+		return color
+	}
+	return "black"
+}
+
+func layerACIColor(layer string) int {
+	if color, ok := layerACI[layer]; ok {
+		return color
+	}
+	return 7
+}
+
+func escapeXML(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;").Replace(s)
+}
+
+func saveDrawing(path string, d *Drawing, writeFunc func(io.Writer, *Drawing) error) error {
+	var f, err = os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeFunc(f, d)
+}
@@ -0,0 +1,48 @@
+package htmlreport
+
+import (
+	"html"
+	"html/template"
+	"io/ioutil"
+	"strings"
+)
+
+// mathText экранирует текст и переводит формулы в нотации LaTeX ($...$ и $$...$$) в разделители
+// MathJax \(...\) и \[...\]. Непарный знак $ выводится как есть.
+func mathText(text string) template.HTML {
+	var result strings.Builder
+	for {
+		var start = strings.IndexByte(text, '$')
+		if start < 0 {
+			break
+		}
+		var delim = "$"
+		if strings.HasPrefix(text[start:], "$$") {
+			delim = "$$"
+		}
+		var end = strings.Index(text[start+len(delim):], delim)
+		if end < 0 {
+			break
+		}
+		var formula = text[start+len(delim) : start+len(delim)+end]
+		result.WriteString(html.EscapeString(text[:start]))
+		if delim == "$$" {
+			result.WriteString(`\[` + html.EscapeString(formula) + `\]`)
+		} else {
+			result.WriteString(`\(` + html.EscapeString(formula) + `\)`)
+		}
+		text = text[start+2*len(delim)+end:]
+	}
+	result.WriteString(html.EscapeString(text))
+	return template.HTML(result.String())
+}
+
+// LoadMathJax читает сборку MathJax (например, es5/tex-svg-full.js из пакета mathjax@3),
+// которая встраивается в страницу отчета, чтобы он открывался без доступа к сети
+func LoadMathJax(path string) (template.JS, error) {
+	var b, err = ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return template.JS(b), nil
+}
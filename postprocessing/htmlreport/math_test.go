@@ -0,0 +1,32 @@
+package htmlreport
+
+import (
+	"github.com/stretchr/testify/assert"
+	"html/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMathText(t *testing.T) {
+	assert.Equal(t, template.HTML(`Вт/(м\(^2\) К) &lt;b&gt;`), mathText("Вт/(м$^2$ К) <b>"))
+	assert.Equal(t, template.HTML("цена $5"), mathText("цена $5"))
+	assert.Equal(t, template.HTML(`a \[x &lt; y\] b`), mathText("a $$x < y$$ b"))
+	assert.Equal(t, template.HTML(`\(T^*_{вх}\), \(\overline{G}_в\)`), mathText(`$T^*_{вх}$, $\overline{G}_в$`))
+}
+
+func TestLoadMathJax(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "mathjax")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var path = filepath.Join(dir, "tex-svg.js")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("window.MathJax = {};"), 0644))
+	script, err := LoadMathJax(path)
+	assert.NoError(t, err)
+	assert.Equal(t, template.JS("window.MathJax = {};"), script)
+
+	_, err = LoadMathJax(filepath.Join(dir, "missing.js"))
+	assert.Error(t, err)
+}
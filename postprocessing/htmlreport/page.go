@@ -0,0 +1,85 @@
+package htmlreport
+
+// pageTemplate не ссылается на внешние файлы: MathJax встраивается в страницу, графики - в SVG
+const pageTemplate = `<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; max-width: 1200px; margin: 0 auto; padding: 1em 2em; line-height: 1.4; }
+nav ul { list-style: none; padding-left: 1.2em; }
+table { border-collapse: collapse; margin: 1em 0; }
+caption { text-align: left; font-style: italic; padding: 0.3em 0; }
+th, td { border: 1px solid #999; padding: 0.25em 0.6em; text-align: center; }
+th { background: #eee; cursor: pointer; user-select: none; }
+th.asc::after { content: " \25B2"; }
+th.desc::after { content: " \25BC"; }
+figure { margin: 1em 0; }
+figure svg { max-width: 100%; height: auto; }
+mjx-container[display="true"] { margin: 0.5em 0; }
+</style>
+{{- if .MathJax}}
+<script>{{.MathJax}}</script>
+{{- end}}
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<nav>
+<ul>
+{{- range .Sections}}{{template "toc" .}}{{end}}
+</ul>
+</nav>
+{{range .Sections}}{{template "section" .}}{{end}}
+<script>
+(function () {
+	function cellValue(row, index) {
+		var text = row.cells[index].textContent.trim();
+		var number = parseFloat(text.replace(",", ".").replace(/[^0-9eE+\-.]/g, ""));
+		return isNaN(number) ? text : number;
+	}
+	document.querySelectorAll("table.sortable").forEach(function (table) {
+		var headers = table.tHead.rows[0].cells;
+		Array.prototype.forEach.call(headers, function (th, index) {
+			th.addEventListener("click", function () {
+				var asc = !th.classList.contains("asc");
+				Array.prototype.forEach.call(headers, function (h) { h.classList.remove("asc", "desc"); });
+				th.classList.add(asc ? "asc" : "desc");
+				var body = table.tBodies[0];
+				var rows = Array.prototype.slice.call(body.rows);
+				rows.sort(function (a, b) {
+					var x = cellValue(a, index), y = cellValue(b, index);
+					var result = (typeof x === "number" && typeof y === "number") ? x - y : String(x).localeCompare(String(y));
+					return asc ? result : -result;
+				});
+				rows.forEach(function (row) { body.appendChild(row); });
+			});
+		});
+	});
+})();
+</script>
+</body>
+</html>
+{{define "toc"}}<li><a href="#{{anchor .Number}}">{{.Number}} {{.Title}}</a>
+{{- if .Subsections}}<ul>{{range .Subsections}}{{template "toc" .}}{{end}}</ul>{{end}}</li>
+{{end}}
+{{define "section"}}<section id="{{anchor .Number}}">
+{{if eq .Level 2}}<h2>{{else if eq .Level 3}}<h3>{{else}}<h4>{{end}}{{.Number}} {{.Title}}{{if eq .Level 2}}</h2>{{else if eq .Level 3}}</h3>{{else}}</h4>{{end}}
+{{range .Paragraphs}}<p>{{math .}}</p>
+{{end}}
+{{- range .Tables}}<table class="sortable">
+<caption>{{math .Caption}}</caption>
+<thead><tr>{{range .Header}}<th>{{math .}}</th>{{end}}</tr></thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{math .}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+{{end}}
+{{- range .Figures}}<figure>
+{{.SVG}}
+<figcaption>{{math .Caption}}</figcaption>
+</figure>
+{{end}}
+{{- range .Subsections}}{{template "section" .}}{{end}}
+</section>
+{{end}}`
@@ -0,0 +1,98 @@
+package htmlreport
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"html/template"
+	"io"
+	"os"
+)
+
+// Report - документ из вложенных разделов. Формулы записываются в тексте и таблицах
+// в нотации LaTeX ($...$ и $$...$$) и отображаются встроенным в страницу MathJax.
+type Report struct {
+	Title    string
+	Sections []Section
+	MathJax  template.JS // см. LoadMathJax; без него формулы выводятся исходным текстом
+}
+
+type Section struct {
+	Title       string
+	Paragraphs  []string
+	Tables      []Table
+	Figures     []Figure
+	Subsections []Section
+}
+
+type Table struct {
+	Caption string
+	Header  []string
+	Rows    [][]string
+}
+
+type Figure struct {
+	Caption string
+	SVG     template.HTML
+}
+
+func (r Report) Render(w io.Writer) error {
+	var t, err = template.New("report").Funcs(template.FuncMap{
+		"anchor": anchor,
+		"math":   mathText,
+	}).Parse(pageTemplate)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, r.numbered())
+}
+
+func (r Report) Save(path string) error {
+	var file, err = os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return r.Render(file)
+}
+
+// numberedSection дополняет раздел номером вида 1.2, как в оглавлении LaTeX
+type numberedSection struct {
+	Section
+	Number      string
+	Level       int
+	Subsections []numberedSection
+}
+
+type numberedReport struct {
+	Lang     locale.Lang
+	Title    string
+	Sections []numberedSection
+	MathJax  template.JS
+}
+
+func (r Report) numbered() numberedReport {
+	return numberedReport{
+		Lang:     locale.Current(),
+		Title:    r.Title,
+		Sections: numberSections(r.Sections, "", 2),
+		MathJax:  r.MathJax,
+	}
+}
+
+func numberSections(sections []Section, prefix string, level int) []numberedSection {
+	var result = make([]numberedSection, len(sections))
+	for i, s := range sections {
+		var number = fmt.Sprintf("%s%d", prefix, i+1)
+		result[i] = numberedSection{
+			Section:     s,
+			Number:      number,
+			Level:       level,
+			Subsections: numberSections(s.Subsections, number+".", level+1),
+		}
+	}
+	return result
+}
+
+func anchor(number string) string {
+	return "section-" + number
+}
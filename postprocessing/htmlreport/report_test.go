@@ -0,0 +1,106 @@
+package htmlreport
+
+import (
+	"bytes"
+	"github.com/Sovianum/cooling-course-project/core/life"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/stretchr/testify/assert"
+	"html/template"
+	"testing"
+)
+
+func testReport() Report {
+	return Report{
+		Title: "Отчет",
+		Sections: []Section{
+			{
+				Title: "Цикл",
+				Subsections: []Section{
+					{
+						Title:      "Узлы",
+						Paragraphs: []string{"$$Nu = 0,079 Re^{0,68}$$"},
+						Tables: []Table{{
+							Caption: "Параметры",
+							Header:  []string{"№", "$T^*$, К"},
+							Rows:    [][]string{{"1", "288,0"}, {"2", "<b>"}},
+						}},
+					},
+				},
+			},
+			{
+				Title:   "Охлаждение",
+				Figures: []Figure{{Caption: "Температуры", SVG: template.HTML(`<svg width="1"></svg>`)}},
+			},
+		},
+	}
+}
+
+func TestReport_Render(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, testReport().Render(&buf))
+	var html = buf.String()
+
+	assert.Contains(t, html, `<html lang="ru">`)
+	assert.NotContains(t, html, "http")
+	assert.Contains(t, html, `<a href="#section-1.1">1.1 Узлы</a>`)
+	assert.Contains(t, html, `<section id="section-2">`)
+	assert.Contains(t, html, "<h3>1.1 Узлы</h3>")
+	assert.Contains(t, html, `<table class="sortable">`)
+	assert.Contains(t, html, `<th>\(T^*\), К</th>`)
+	assert.Contains(t, html, "<td>288,0</td>")
+	assert.Contains(t, html, "<td>&lt;b&gt;</td>")
+	assert.Contains(t, html, `<p>\[Nu = 0,079 Re^{0,68}\]</p>`)
+	assert.Contains(t, html, `<svg width="1"></svg>`)
+	assert.Contains(t, html, "<figcaption>Температуры</figcaption>")
+	assert.NotContains(t, html, "<script>window.MathJax")
+
+	var report = testReport()
+	report.MathJax = "window.MathJax = {};"
+	buf.Reset()
+	assert.NoError(t, report.Render(&buf))
+	assert.Contains(t, buf.String(), "<script>window.MathJax = {};</script>")
+	assert.NotContains(t, buf.String(), "<script src")
+}
+
+func TestNumberSections(t *testing.T) {
+	var sections = numberSections(testReport().Sections, "", 2)
+	assert.Equal(t, "1", sections[0].Number)
+	assert.Equal(t, 2, sections[0].Level)
+	assert.Equal(t, "1.1", sections[0].Subsections[0].Number)
+	assert.Equal(t, 3, sections[0].Subsections[0].Level)
+	assert.Equal(t, "2", sections[1].Number)
+}
+
+func TestParameterTable_English(t *testing.T) {
+	defer locale.Set(locale.Current())
+	locale.Set(locale.English)
+
+	var table = parameterTable("Исходные данные", [][]string{
+		{"Температура газа", "$T_г$", "К", "1450,0"},
+	})
+	assert.Equal(t, "Input data", table.Caption)
+	assert.Equal(t, []string{"Parameter", "Symbol", "Unit", "Value"}, table.Header)
+	assert.Equal(t, [][]string{{"Gas temperature", "$T_g$", "K", "1450,0"}}, table.Rows)
+
+	var buf bytes.Buffer
+	assert.NoError(t, Report{Title: "Report"}.Render(&buf))
+	assert.Contains(t, buf.String(), `<html lang="en">`)
+}
+
+func TestLifeSection(t *testing.T) {
+	var section = LifeSection(dataframes.LifeDF{
+		Material:    "ЖС6К",
+		CreepPassed: true,
+		Sections: []life.SectionLife{
+			{Section: life.Section{HRel: 0, Radius: 0.3, TWall: 1100}, Stress: 200e6, CreepLife: 12e3},
+			{Section: life.Section{HRel: 1}},
+		},
+	})
+	assert.Len(t, section.Paragraphs, 2)
+	assert.Contains(t, section.Paragraphs[1], "циклическая долговечность")
+
+	var rows = section.Tables[1].Rows
+	assert.Len(t, rows, 2)
+	assert.Equal(t, []string{"1", "0,00", "300,0", "1100,0", "0,0", "200,0", "12,0", "0,0"}, rows[0])
+}
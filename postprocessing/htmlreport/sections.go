@@ -0,0 +1,394 @@
+package htmlreport
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/plotting"
+	"gonum.org/v1/plot"
+	"html/template"
+)
+
+// Data - результаты расчетов, из которых строятся разделы, соответствующие root.tex.
+// Подписи выводятся на текущем языке пакета locale.
+type Data struct {
+	Cycle    dataframes.ThreeShaftsDF
+	LPC      dataframes.StagedCompressorDF
+	HPC      dataframes.StagedCompressorDF
+	Turbine  dataframes.StagedTurbineDF
+	Gap      dataframes.GapCalcDF
+	TProfile dataframes.TProfileCalcDF
+
+	Equilibrium  dataframes.RadialEquilibriumDF
+	RotorCooling dataframes.RotorCoolingDF
+	Life         dataframes.LifeDF
+
+	PlotOptions plotting.Options
+}
+
+func NewReport(data Data) (Report, error) {
+	var coolingSection, err = TProfileSection(data.TProfile, data.PlotOptions)
+	if err != nil {
+		return Report{}, err
+	}
+	return Report{
+		Title: locale.T("Пояснительная записка"),
+		Sections: []Section{
+			{
+				Title: locale.T("Расчетно-конструкторская часть"),
+				Subsections: []Section{
+					CycleSection(data.Cycle),
+					{
+						Title: locale.T("Расчет компрессоров"),
+						Subsections: []Section{
+							StagedCompressorSection(locale.T("КНД"), data.LPC),
+							StagedCompressorSection(locale.T("КВД"), data.HPC),
+						},
+					},
+					StagedTurbineSection(locale.T("Турбина"), data.Turbine),
+					RadialEquilibriumSection(data.Equilibrium),
+				},
+			},
+			{
+				Title: locale.T("Научно-исследовательская часть"),
+				Subsections: []Section{
+					GapCalcSection(data.Gap),
+					coolingSection,
+					RotorCoolingSection(data.RotorCooling),
+					LifeSection(data.Life),
+				},
+			},
+		},
+	}, nil
+}
+
+func CycleSection(df dataframes.ThreeShaftsDF) Section {
+	var nodes = Table{
+		Caption: locale.Tf("Параметры узлов: %s", df.Title()),
+		Header:  translated("№", "Узел", "$p^*_{вх}$, МПа", "$p^*_{вых}$, МПа", "$T^*_{вх}$, К", "$T^*_{вых}$, К"),
+	}
+	for _, row := range df.NodeRows() {
+		nodes.Rows = append(nodes.Rows, []string{
+			fmt.Sprint(row.Id), row.Name,
+			dataframes.Round3(dataframes.DivideE6(float64(row.PIn))), dataframes.Round3(dataframes.DivideE6(float64(row.POut))),
+			dataframes.Round1(float64(row.TIn)), dataframes.Round1(float64(row.TOut)),
+		})
+	}
+
+	return Section{
+		Title: locale.T("Расчет цикла"),
+		Tables: []Table{
+			nodes,
+			parameterTable("Основные показатели двигателя", [][]string{
+				{"Мощность", "$N_e$", "МВт", dataframes.Round2(dataframes.DivideE6(df.Ne))},
+				{"Механическая мощность", "$N_{e\\ мех}$", "МВт", dataframes.Round2(dataframes.DivideE6(df.NeMech))},
+				{"Удельная работа", "$L_e$", "кДж/кг", dataframes.Round1(dataframes.DivideE3(float64(df.EngineLabour)))},
+				{"КПД", "$\\eta_e$", "-", dataframes.Round3(df.Eta)},
+				{"Удельный расход топлива", "$C_e$", "кг/(кВт ч)", "$" + dataframes.Round3(dataframes.MultiplyE3(df.Ce)) + " \\cdot 10^{-3}$"},
+				{"Расход воздуха", "$G$", "кг/с", dataframes.Round2(float64(df.MassRate))},
+			}),
+		},
+	}
+}
+
+func StagedCompressorSection(title string, df dataframes.StagedCompressorDF) Section {
+	return Section{
+		Title:  title,
+		Tables: []Table{stageTable(locale.Tf("Результаты расчета ступеней: %s", title), df.Rows())},
+	}
+}
+
+func StagedTurbineSection(title string, df dataframes.StagedTurbineDF) Section {
+	return Section{
+		Title:  title,
+		Tables: []Table{stageTable(locale.Tf("Результаты расчета ступеней: %s", title), df.Rows())},
+	}
+}
+
+func RadialEquilibriumSection(df dataframes.RadialEquilibriumDF) Section {
+	var rows = Table{
+		Caption: locale.T("Распределение параметров по высоте в осевых зазорах"),
+		Header: translated(
+			"№", "$\\overline{h}$",
+			"$r_1$, мм", "$c_{1a}$, м/с", "$c_{1u}$, м/с", "$p_1$, МПа",
+			"$r_2$, мм", "$c_{2a}$, м/с", "$c_{2u}$, м/с", "$p_2$, МПа",
+			"$\\rho_т$",
+		),
+	}
+	for _, row := range df.Rows {
+		rows.Rows = append(rows.Rows, []string{
+			fmt.Sprint(row.Id),
+			dataframes.Round2(row.HRel),
+			dataframes.Round1(dataframes.MultiplyE3(row.R1)),
+			dataframes.Round1(row.CA1),
+			dataframes.Round1(row.CU1),
+			dataframes.Round3(dataframes.DivideE6(row.P1)),
+			dataframes.Round1(dataframes.MultiplyE3(row.R2)),
+			dataframes.Round1(row.CA2),
+			dataframes.Round1(row.CU2),
+			dataframes.Round3(dataframes.DivideE6(row.P2)),
+			dataframes.Round3(row.Reactivity),
+		})
+	}
+
+	var paragraphs []string
+	if df.NegativeHubReactivity {
+		paragraphs = append(paragraphs, locale.T(
+			"Внимание: степень реактивности у втулки отрицательна, закон профилирования следует изменить.",
+		))
+	}
+	return Section{
+		Title:      locale.T("Расчет ступени по уравнению радиального равновесия"),
+		Paragraphs: paragraphs,
+		Tables:     []Table{rows},
+	}
+}
+
+func GapCalcSection(df dataframes.GapCalcDF) Section {
+	var rows = Table{
+		Caption: locale.T("Зависимость глубины охлаждения от расхода воздуха"),
+		Header:  translated("№", "$\\overline{G}_в$", "$D$", "$\\varepsilon$", "$\\delta$, мм"),
+	}
+	for row := range df.Gas.TableRows() {
+		rows.Rows = append(rows.Rows, []string{
+			fmt.Sprint(row.Id),
+			dataframes.Round3(row.AirMassRate),
+			dataframes.Round3(row.DCoef),
+			dataframes.Round3(row.EpsCoef),
+			dataframes.Round2(dataframes.MultiplyE3(row.AirGap)),
+		})
+	}
+
+	var paragraphs = []string{
+		locale.Tf("Число Нуссельта для газа определено по зависимости %s: $$%s$$", df.Gas.NuLawTitle, df.Gas.NuFormula),
+	}
+	if !df.Gas.NuReInRange {
+		paragraphs = append(paragraphs, locale.Tf(
+			"Внимание: число Рейнольдса $Re = %s$ вне диапазона применимости зависимости.",
+			dataframes.Round(df.Gas.ReGas),
+		))
+	}
+
+	return Section{
+		Title:      locale.T("Расчет охлаждения лопатки с дефлектором"),
+		Paragraphs: paragraphs,
+		Tables: []Table{
+			parameterTable("Исходные данные", [][]string{
+				{"Температура газа", "$T_г$", "К", dataframes.Round1(df.Gas.Tg)},
+				{"Начальная температура охлаждающего воздуха", "$\\theta_0$", "К", dataframes.Round1(df.Gas.Theta0)},
+				{"Длина лопатки", "$l$", "мм", dataframes.Round1(dataframes.MultiplyE3(df.Geom.BladeLength))},
+				{"Осевая проекция хорды", "$b_a$", "мм", dataframes.Round1(dataframes.MultiplyE3(df.Geom.ChordProjection))},
+				{"Толщина стенки", "$\\Delta$", "мм", dataframes.Round1(dataframes.MultiplyE3(df.Geom.WallThk))},
+				{"Средняя температура наружной поверхности", "$T_{ст}$", "К", dataframes.Round1(df.Metal.TWallOuter)},
+				{"Число Рейнольдса", "$Re_г$", "-", dataframes.Round(df.Gas.ReGas)},
+				{"Число Нуссельта", "$Nu_г$", "-", dataframes.Round(df.Gas.NuGas)},
+				{"Коэффициент теплоотдачи от газа", "$\\alpha_г$", "Вт/(м$^2$ К)", dataframes.Round1(df.Gas.AlphaGas)},
+				{"Тепловой поток", "$Q$", "кВт", dataframes.Round1(dataframes.DivideE3(df.Gas.Heat))},
+			}),
+			rows,
+		},
+	}
+}
+
+// TProfileSection строит таблицы и рисунки распределения температур по обводу профиля
+func TProfileSection(df dataframes.TProfileCalcDF, opts plotting.Options) (Section, error) {
+	var gas = df.Gas
+	var ps = plotting.CoolingData{
+		L: gas.LengthPSArr, AlphaAir: gas.AlphaAirPSArr, AlphaGas: gas.AlphaGasPSArr,
+		TAir: gas.TAirPSArr, TWall: gas.TWallPSArr,
+	}
+	var ss = plotting.CoolingData{
+		L: gas.LengthSSArr, AlphaAir: gas.AlphaAirSSArr, AlphaGas: gas.AlphaGasSSArr,
+		TAir: gas.TAirSSArr, TWall: gas.TWallSSArr,
+	}
+
+	var section = Section{
+		Title: locale.T("Расчет температурного состояния профиля"),
+		Tables: []Table{
+			parameterTable("Исходные данные", [][]string{
+				{"Диаметр входной кромки", "$d_{вх}$", "мм", dataframes.Round2(dataframes.MultiplyE3(df.Geom.DInlet))},
+				{"Осевая скорость газа", "$c_a$", "м/с", dataframes.Round1(gas.Ca)},
+				{"Плотность газа", "$\\rho_г$", "кг/м$^3$", dataframes.Round2(gas.RhoGas)},
+				{"Средний коэффициент теплоотдачи", "$\\alpha_{ср}$", "Вт/(м$^2$ К)", dataframes.Round1(gas.AlphaMean)},
+				{"Коэффициент теплоотдачи на входной кромке", "$\\alpha_{вх}$", "Вт/(м$^2$ К)", dataframes.Round1(gas.AlphaGasInlet)},
+				{"Коэффициент теплоотдачи на выходной кромке", "$\\alpha_{вых}$", "Вт/(м$^2$ К)", dataframes.Round1(gas.AlphaGasOutlet)},
+			}),
+			profileTable(locale.T("Распределение параметров по корытцу"), ps, gas.SkipSteps),
+			profileTable(locale.T("Распределение параметров по спинке"), ss, gas.SkipSteps),
+		},
+	}
+
+	var t, err = plotting.CoolingTemperature(ps, ss)
+	if err != nil {
+		return Section{}, err
+	}
+	alpha, err := plotting.CoolingAlpha(ps, ss)
+	if err != nil {
+		return Section{}, err
+	}
+	for _, item := range []struct {
+		caption string
+		p       *plot.Plot
+	}{
+		{locale.T("Распределение температур по обводу профиля"), t},
+		{locale.T("Распределение коэффициентов теплоотдачи по обводу профиля"), alpha},
+	} {
+		var figure, err = NewFigure(item.caption, item.p, opts)
+		if err != nil {
+			return Section{}, err
+		}
+		section.Figures = append(section.Figures, figure)
+	}
+	return section, nil
+}
+
+func RotorCoolingSection(df dataframes.RotorCoolingDF) Section {
+	var rows = Table{
+		Caption: locale.T("Результаты расчета охлаждения рабочей лопатки"),
+		Header: translated(
+			"№", "$\\overline{h}$", "$r$, мм", "$w$, м/с", "$T^*_w$, К", "$T_в$, К", "$p_в$, МПа",
+			"$\\alpha_г$, Вт/(м$^2$ К)", "$T_{ст\\ max}$, К", "$\\Delta T_{ст}$, К",
+		),
+	}
+	for _, s := range df.Sections {
+		rows.Rows = append(rows.Rows, []string{
+			fmt.Sprint(s.Id),
+			dataframes.Round2(s.HRel),
+			dataframes.Round1(dataframes.MultiplyE3(s.Radius)),
+			dataframes.Round1(s.W),
+			dataframes.Round1(s.TStagW),
+			dataframes.Round1(s.TCooler),
+			dataframes.Round3(dataframes.DivideE6(s.PCooler)),
+			dataframes.Round(s.AlphaGas),
+			dataframes.Round1(s.TWallMax),
+			dataframes.Round1(s.DTWall),
+		})
+	}
+
+	return Section{
+		Title: locale.T("Расчет охлаждения рабочей лопатки"),
+		Paragraphs: []string{locale.Tf(
+			"Расход охлаждающего воздуха $G_в = %s$ кг/с.", dataframes.Round3(df.AirMassRate),
+		)},
+		Tables: []Table{rows},
+	}
+}
+
+func LifeSection(df dataframes.LifeDF) Section {
+	var rows = Table{
+		Caption: locale.T("Ресурс рабочей лопатки по сечениям"),
+		Header: translated(
+			"№", "$\\overline{h}$", "$r$, мм", "$T_{ст}$, К", "$\\Delta T$, К", "$\\sigma_р$, МПа",
+			"$\\tau$, $10^3$ ч", "$N$, $10^3$",
+		),
+	}
+	for row := range df.TableRows() {
+		rows.Rows = append(rows.Rows, []string{
+			fmt.Sprint(row.Id),
+			dataframes.Round2(row.HRel),
+			dataframes.Round1(dataframes.MultiplyE3(row.Radius)),
+			dataframes.Round1(row.TWall),
+			dataframes.Round1(row.DTWall),
+			dataframes.Round1(dataframes.DivideE6(row.Stress)),
+			dataframes.Round1(dataframes.DivideE3(row.CreepLife)),
+			dataframes.Round1(dataframes.DivideE3(row.LCFCycles)),
+		})
+	}
+
+	var paragraphs = []string{locale.Tf(
+		"Минимальное время до разрушения $\\tau_{min} = %s$ ч достигается в сечении $\\overline{h} = %s$, "+
+			"минимальное число циклов $N_{min} = %s$ - в сечении $\\overline{h} = %s$.",
+		dataframes.Round(df.MinCreepLife), dataframes.Round2(df.MinCreepHRel),
+		dataframes.Round(df.MinLCFCycles), dataframes.Round2(df.MinLCFHRel),
+	)}
+	if !df.CreepPassed {
+		paragraphs = append(paragraphs, locale.T("Внимание: недостаточна длительная прочность ($\\tau_{min} < \\tau_{тр}$)."))
+	}
+	if !df.LCFPassed {
+		paragraphs = append(paragraphs, locale.T("Внимание: недостаточна циклическая долговечность ($N_{min} < N_{тр}$)."))
+	}
+
+	return Section{
+		Title:      locale.T("Оценка ресурса рабочей лопатки"),
+		Paragraphs: paragraphs,
+		Tables: []Table{
+			parameterTable("Исходные данные", [][]string{
+				{"Материал лопатки", "-", "-", df.Material},
+				{"Частота вращения ротора", "$n$", "об/мин", dataframes.Round(df.RPM)},
+				{"Требуемый ресурс", "$\\tau_{тр}$", "ч", dataframes.Round(df.RequiredLife)},
+				{"Требуемое число циклов", "$N_{тр}$", "-", dataframes.Round(df.RequiredCycles)},
+			}),
+			rows,
+		},
+	}
+}
+
+func NewFigure(caption string, p *plot.Plot, opts plotting.Options) (Figure, error) {
+	var svg, err = plotting.EncodeSVG(p, opts)
+	if err != nil {
+		return Figure{}, err
+	}
+	// пролог XML недопустим внутри HTML
+	if i := bytes.Index(svg, []byte("<svg")); i > 0 {
+		svg = svg[i:]
+	}
+	return Figure{Caption: caption, SVG: template.HTML(svg)}, nil
+}
+
+func stageTable(caption string, rows []dataframes.StageRow) Table {
+	var result = Table{Caption: caption, Header: translated("№", "Параметр", "Размерность")}
+	if len(rows) > 0 {
+		for i := range rows[0].Values {
+			result.Header = append(result.Header, locale.Tf("Ступень %d", i+1))
+		}
+	}
+	for _, row := range rows {
+		result.Rows = append(result.Rows, append(
+			[]string{fmt.Sprint(row.ID), locale.T(row.Name), locale.T(row.Dimension)}, row.Strings()...,
+		))
+	}
+	return result
+}
+
+// parameterTable переводит подписи caption и первые три столбца строк: наименование, обозначение и размерность
+func parameterTable(caption string, rows [][]string) Table {
+	var result = Table{
+		Caption: locale.T(caption),
+		Header:  translated("Параметр", "Обозначение", "Размерность", "Значение"),
+	}
+	for _, row := range rows {
+		var labels = translated(row[:3]...)
+		result.Rows = append(result.Rows, append(labels, row[3:]...))
+	}
+	return result
+}
+
+func profileTable(caption string, data plotting.CoolingData, step int) Table {
+	if step <= 0 {
+		step = 1
+	}
+	var result = Table{
+		Caption: caption,
+		Header:  translated("№", "$x$, мм", "$\\alpha_в$", "$\\alpha_г$", "$T_в$, К", "$T_{ст}$, К"),
+	}
+	for i, j := 0, 1; i < data.Len(); i, j = i+step, j+1 {
+		result.Rows = append(result.Rows, []string{
+			fmt.Sprint(j),
+			dataframes.Round2(dataframes.MultiplyE3(data.L[i])),
+			dataframes.Round1(plotting.ValueAt(data.AlphaAir, i)),
+			dataframes.Round1(plotting.ValueAt(data.AlphaGas, i)),
+			dataframes.Round1(plotting.ValueAt(data.TAir, i)),
+			dataframes.Round1(plotting.ValueAt(data.TWall, i)),
+		})
+	}
+	return result
+}
+
+func translated(msgs ...string) []string {
+	var result = make([]string, len(msgs))
+	for i, msg := range msgs {
+		result[i] = locale.T(msg)
+	}
+	return result
+}
@@ -0,0 +1,6 @@
+package locale
+
+// catalogs содержит переводы для всех языков, кроме русского
+var catalogs = map[Lang]map[string]string{
+	English: english,
+}
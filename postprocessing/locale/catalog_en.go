@@ -0,0 +1,238 @@
+package locale
+
+// english - переводы сообщений отчетов на английский язык; ключ - исходное сообщение на русском
+var english = map[string]string{
+	// разделы
+	"Пояснительная записка":                   "Explanatory note",
+	"Сводка по проекту":                       "Project summary",
+	"Расчетно-конструкторская часть":          "Design calculations",
+	"Научно-исследовательская часть":          "Research",
+	"Расчет цикла":                            "Cycle calculation",
+	"Расчет компрессоров":                     "Compressor calculation",
+	"Турбина":                                 "Turbine",
+	"Расчет охлаждения лопатки с дефлектором": "Cooling of a blade with a deflector",
+	"Расчет температурного состояния профиля": "Profile temperature state",
+	"Цикл":            "Cycle",
+	"Ступени":         "Stages",
+	"Охлаждение":      "Cooling",
+	"Схема: %s":       "Scheme: %s",
+	"КНД":             "LPC",
+	"КВД":             "HPC",
+	"ТВД":             "HPT",
+	"ТНД":             "LPT",
+	"СТ":              "FT",
+	"Исходные данные": "Input data",
+
+	// схемы и узлы
+	"двухвальная схема":                                             "two-shaft scheme",
+	"двухвальная схема с регенератором":                             "two-shaft scheme with a regenerator",
+	"трехвальная схема":                                             "three-shaft scheme",
+	"трехвальная схема с регенератором":                             "three-shaft scheme with a regenerator",
+	"трехвальная схема с промежуточным охлаждением":                 "three-shaft scheme with intercooling",
+	"трехвальная схема с промежуточным охлаждением и регенератором": "three-shaft scheme with intercooling and a regenerator",
+	"трехвальная схема с промежуточным подогревом":                  "three-shaft scheme with reheat",
+	"трехвальная схема с дожимающим компрессором":                   "three-shaft scheme with a booster compressor",
+	"Входное устройство":                                            "Inlet",
+	"Выходное устройство":                                           "Exhaust",
+	"Компрессор":                                                    "Compressor",
+	"Камера сгорания":                                               "Combustor",
+	"Турбина компрессора":                                           "Compressor turbine",
+	"Канал за турбиной компрессора":                                 "Duct after the compressor turbine",
+	"Свободная турбина":                                             "Free turbine",
+	"Канал за КНД":                                                  "Duct after the LPC",
+	"Канал за КВД":                                                  "Duct after the HPC",
+	"Канал за ТВД":                                                  "Duct after the HPT",
+	"Канал за ТНД":                                                  "Duct after the LPT",
+	"Промежуточный охладитель":                                      "Intercooler",
+	"Промежуточная камера сгорания":                                 "Reheat combustor",
+	"Дожимающий компрессор":                                         "Booster compressor",
+	"Охладитель":                                                    "Cooler",
+	"Регенератор (воздух)":                                          "Regenerator (air)",
+	"Регенератор (газ)":                                             "Regenerator (gas)",
+	"%s, ступень %d, РК":                                            "%s, stage %d, rotor",
+	"%s, ступень %d, НА":                                            "%s, stage %d, stator",
+	"сопловой аппарат":                                              "nozzle vane",
+	"рабочее колесо":                                                "rotor blade",
+	"Основные показатели двигателя":                                 "Engine performance",
+	"Параметры узлов: %s":                                           "Node parameters: %s",
+	"Результаты расчета ступеней: %s":                               "Stage calculation results: %s",
+	"Распределение параметров по корытцу":                           "Pressure side distribution",
+	"Распределение параметров по спинке":                            "Suction side distribution",
+	"Распределение температур по обводу профиля":                    "Temperature distribution along the profile contour",
+	"Распределение коэффициентов теплоотдачи по обводу профиля": "Heat transfer coefficient distribution along the profile contour",
+	"Зависимость глубины охлаждения от расхода воздуха":         "Cooling depth versus cooling air mass rate",
+
+	"Расчет ступени по уравнению радиального равновесия":  "Stage calculation by radial equilibrium",
+	"Распределение параметров по высоте в осевых зазорах": "Spanwise distribution in the axial gaps",
+	"Расчет охлаждения рабочей лопатки":                   "Rotor blade cooling",
+	"Результаты расчета охлаждения рабочей лопатки":       "Rotor blade cooling results",
+	"Оценка ресурса рабочей лопатки":                      "Rotor blade life assessment",
+	"Ресурс рабочей лопатки по сечениям":                  "Rotor blade life by section",
+
+	"Число Нуссельта для газа определено по зависимости %s: $$%s$$":                                "Gas Nusselt number is computed with the %s correlation: $$%s$$",
+	"Внимание: число Рейнольдса $Re = %s$ вне диапазона применимости зависимости.":                 "Warning: Reynolds number $Re = %s$ is outside the validity range of the correlation.",
+	"Внимание: степень реактивности у втулки отрицательна, закон профилирования следует изменить.": "Warning: hub reaction is negative, the vortex law should be changed.",
+	"Расход охлаждающего воздуха $G_в = %s$ кг/с.":                                                 "Cooling air mass rate $G_a = %s$ kg/s.",
+	"Минимальное время до разрушения $\\tau_{min} = %s$ ч достигается в сечении $\\overline{h} = %s$, " +
+		"минимальное число циклов $N_{min} = %s$ - в сечении $\\overline{h} = %s$.": "Minimum rupture life $\\tau_{min} = %s$ h is reached at $\\overline{h} = %s$, " +
+		"minimum number of cycles $N_{min} = %s$ at $\\overline{h} = %s$.",
+	"Внимание: недостаточна длительная прочность ($\\tau_{min} < \\tau_{тр}$).":        "Warning: insufficient creep life ($\\tau_{min} < \\tau_{req}$).",
+	"Внимание: недостаточна циклическая долговечность ($N_{min} < N_{тр}$).":           "Warning: insufficient low-cycle fatigue life ($N_{min} < N_{req}$).",
+	"$T_г = %s$ К, $\\theta_0 = %s$ К, допустимая температура стенки $T_{ст} = %s$ К.": "$T_g = %s$ K, $\\theta_0 = %s$ K, allowed wall temperature $T_w = %s$ K.",
+
+	// заголовки таблиц
+	"№":           "No.",
+	"Узел":        "Node",
+	"Параметр":    "Parameter",
+	"Обозначение": "Symbol",
+	"Размерность": "Unit",
+	"Значение":    "Value",
+	"Ступень %d":  "Stage %d",
+	"Корытце":     "Pressure side",
+	"Спинка":      "Suction side",
+
+	"$p^*_{вх}$, МПа":  "$p^*_{in}$, MPa",
+	"$p^*_{вых}$, МПа": "$p^*_{out}$, MPa",
+	"$T^*_{вх}$, К":    "$T^*_{in}$, K",
+	"$T^*_{вых}$, К":   "$T^*_{out}$, K",
+	"$\\delta$, мм":    "$\\delta$, mm",
+	"$x$, мм":          "$x$, mm",
+	"$T_в$, К":         "$T_a$, K",
+	"$T_{ст}$, К":      "$T_w$, K",
+
+	"$T_{ст\\ max}$, К":         "$T_{w\\ max}$, K",
+	"$\\overline{h}$":           "$\\overline{h}$",
+	"$r$, мм":                   "$r$, mm",
+	"$r_1$, мм":                 "$r_1$, mm",
+	"$r_2$, мм":                 "$r_2$, mm",
+	"$c_{1a}$, м/с":             "$c_{1a}$, m/s",
+	"$c_{1u}$, м/с":             "$c_{1u}$, m/s",
+	"$c_{2a}$, м/с":             "$c_{2a}$, m/s",
+	"$c_{2u}$, м/с":             "$c_{2u}$, m/s",
+	"$p_1$, МПа":                "$p_1$, MPa",
+	"$p_2$, МПа":                "$p_2$, MPa",
+	"$\\rho_т$":                 "$\\rho_t$",
+	"$w$, м/с":                  "$w$, m/s",
+	"$T^*_w$, К":                "$T^*_w$, K",
+	"$p_в$, МПа":                "$p_a$, MPa",
+	"$\\alpha_г$, Вт/(м$^2$ К)": "$\\alpha_g$, W/(m$^2$ K)",
+	"$\\Delta T_{ст}$, К":       "$\\Delta T_w$, K",
+	"$\\Delta T$, К":            "$\\Delta T$, K",
+	"$\\sigma_р$, МПа":          "$\\sigma_r$, MPa",
+	"$\\tau$, $10^3$ ч":         "$\\tau$, $10^3$ h",
+	"Запас $T_{ст} - T_{ст\\ max}$, К": "Margin $T_w - T_{w\\ max}$, K",
+	"$T_в$ на выходе, К":               "Outlet $T_a$, K",
+
+	// параметры
+	"Мощность":                                   "Power",
+	"Механическая мощность":                      "Mechanical power",
+	"Удельная работа":                            "Specific work",
+	"КПД":                                        "Efficiency",
+	"Удельный расход топлива":                    "Specific fuel consumption",
+	"Расход воздуха":                             "Air mass rate",
+	"Температура газа":                           "Gas temperature",
+	"Длина лопатки":                              "Blade length",
+	"Осевая проекция хорды":                      "Axial chord",
+	"Толщина стенки":                             "Wall thickness",
+	"Число Рейнольдса":                           "Reynolds number",
+	"Число Нуссельта":                            "Nusselt number",
+	"Материал лопатки":                           "Blade material",
+	"Частота вращения ротора":                    "Rotor speed",
+	"Требуемый ресурс":                           "Required life",
+	"Требуемое число циклов":                     "Required number of cycles",
+	"Тепловой поток":                             "Heat flux",
+	"Диаметр входной кромки":                     "Leading edge diameter",
+	"Осевая скорость газа":                       "Gas axial velocity",
+	"Плотность газа":                             "Gas density",
+	"Средний коэффициент теплоотдачи":            "Mean heat transfer coefficient",
+	"Коэффициент теплоотдачи от газа":            "Gas heat transfer coefficient",
+	"Коэффициент теплоотдачи на входной кромке":  "Leading edge heat transfer coefficient",
+	"Коэффициент теплоотдачи на выходной кромке": "Trailing edge heat transfer coefficient",
+	"Начальная температура охлаждающего воздуха": "Cooling air inlet temperature",
+	"Средняя температура наружной поверхности":   "Mean outer wall temperature",
+
+	"Мощность $N_e$":                                "Power $N_e$",
+	"КПД $\\eta_e$":                                 "Efficiency $\\eta_e$",
+	"Удельная работа $L_e$":                         "Specific work $L_e$",
+	"Расход воздуха $G_в$":                          "Air mass rate $G_a$",
+	"Расход топлива $G_т$":                          "Fuel mass rate $G_f$",
+	"Удельный расход топлива $C_e$":                 "Specific fuel consumption $C_e$",
+	"Температура газа $T_г^*$":                      "Gas temperature $T_g^*$",
+	"Степень повышения давления КНД $\\pi_{кнд}^*$": "LPC pressure ratio $\\pi_{lpc}^*$",
+	"Степень повышения давления КВД $\\pi_{квд}^*$": "HPC pressure ratio $\\pi_{hpc}^*$",
+
+	// обозначения
+	"$N_{e\\ мех}$":     "$N_{e\\ mech}$",
+	"$\\tau_{тр}$":      "$\\tau_{req}$",
+	"$N_{тр}$":          "$N_{req}$",
+	"$T_г$":             "$T_g$",
+	"$T_{ст}$":          "$T_w$",
+	"$Re_г$":            "$Re_g$",
+	"$Nu_г$":            "$Nu_g$",
+	"$\\alpha_г$":       "$\\alpha_g$",
+	"$\\alpha_в$":       "$\\alpha_a$",
+	"$\\alpha_{ср}$":    "$\\alpha_m$",
+	"$\\alpha_{вх}$":    "$\\alpha_{le}$",
+	"$\\alpha_{вых}$":   "$\\alpha_{te}$",
+	"$\\rho_г$":         "$\\rho_g$",
+	"$d_{вх}$":          "$d_{le}$",
+	"$\\overline{G}_в$": "$\\overline{G}_a$",
+
+	"$H_{ад}$":               "$H_{ad}$",
+	"$H_л$":                  "$H_r$",
+	"$H_с$":                  "$H_s$",
+	"$H_т$":                  "$H_t$",
+	"$H_т^*$":                "$H_t^*$",
+	"$L_т$":                  "$L_t$",
+	"$\\eta_{т \\/\\ мощн}$": "$\\eta_{t\\ pow}$",
+	"$\\eta_т^*$":            "$\\eta_t^*$",
+	"$\\pi_т$":               "$\\pi_t$",
+	"$\\overline{r_{ср1}}$":  "$\\overline{r_{m1}}$",
+	"$\\overline{r_{ср3}}$":  "$\\overline{r_{m3}}$",
+	"$a_{кр1}$":              "$a_{cr1}$",
+	"$a_{кр3}$":              "$a_{cr3}$",
+	"$c_{1ад}$":              "$c_{1ad}$",
+	"$w_{2ад}$":              "$w_{2ad}$",
+	"$h_{вент}$":             "$h_{vent}$",
+	"$h_{вых}$":              "$h_{out}$",
+	"$h_з$":                  "$h_{gap}$",
+	"$h_р$":                  "$h_r$",
+	"$h_с$":                  "$h_s$",
+	"$x_{ступ}$":             "$x_{st}$",
+
+	// единицы
+	"К":            "K",
+	"мм":           "mm",
+	"м":            "m",
+	"м/с":          "m/s",
+	"МВт":          "MW",
+	"кВт":          "kW",
+	"кДж/кг":       "kJ/kg",
+	"кг/с":         "kg/s",
+	"кг/(кВт ч)":   "kg/(kW h)",
+	"об/мин":       "rpm",
+	"ч":            "h",
+	"кг/м$^3$":     "kg/m$^3$",
+	"Вт/(м$^2$ К)": "W/(m$^2$ K)",
+
+	"$К$":                 "$K$",
+	"$м$":                 "$m$",
+	"$м^2$":               "$m^2$",
+	"$м/с$":               "$m/s$",
+	"$МПа$":               "$MPa$",
+	"$кг/м^3$":            "$kg/m^3$",
+	"$10^6 \\cdot Па$":    "$10^6 \\cdot Pa$",
+	"$10^3 \\cdot Дж/кг$": "$10^3 \\cdot J/kg$",
+	"$10^5 \\cdot Дж/кг$": "$10^5 \\cdot J/kg$",
+	"$10^6 \\cdot Дж/кг$": "$10^6 \\cdot J/kg$",
+
+	// подписи графиков
+	"x, мм":  "x, mm",
+	"T, К":   "T, K",
+	"Tст пр": "Tw eq",
+	"Tст":    "Tw",
+	"Tв":     "Ta",
+	"Tпл":    "Tf",
+	"αв":     "αa",
+	"αпл":    "αf",
+}
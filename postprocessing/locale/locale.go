@@ -0,0 +1,72 @@
+package locale
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lang - язык генерируемых отчетов
+type Lang string
+
+const (
+	Russian Lang = "ru"
+	English Lang = "en"
+)
+
+// Langs - поддерживаемые языки; первый из них - язык исходных сообщений
+var Langs = []Lang{Russian, English}
+
+var current = Russian
+
+func Parse(name string) (Lang, error) {
+	for _, lang := range Langs {
+		if string(lang) == name {
+			return lang, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported language %q", name)
+}
+
+// Set задает язык, на котором выводятся сообщения и числа во всех отчетах
+func Set(lang Lang) {
+	current = lang
+}
+
+func Current() Lang {
+	return current
+}
+
+// T возвращает перевод сообщения msg на текущий язык
+func T(msg string) string {
+	return current.T(msg)
+}
+
+// Tf переводит строку формата и подставляет в нее args
+func Tf(format string, args ...interface{}) string {
+	return fmt.Sprintf(T(format), args...)
+}
+
+// Decimal заменяет десятичную точку в числе, выведенном fmt или strconv, на разделитель текущего языка
+func Decimal(number string) string {
+	return current.Decimal(number)
+}
+
+// T возвращает перевод сообщения msg. Сообщения записываются по-русски,
+// при отсутствии перевода в каталоге возвращается само сообщение.
+func (lang Lang) T(msg string) string {
+	if translated, ok := catalogs[lang][msg]; ok {
+		return translated
+	}
+	return msg
+}
+
+func (lang Lang) DecimalSeparator() string {
+	if lang == Russian {
+		return ","
+	}
+	return "."
+}
+
+func (lang Lang) Decimal(number string) string {
+	return strings.Replace(number, ".", lang.DecimalSeparator(), -1)
+}
@@ -0,0 +1,53 @@
+package locale
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	var lang, err = Parse("en")
+	assert.NoError(t, err)
+	assert.Equal(t, English, lang)
+
+	_, err = Parse("de")
+	assert.Error(t, err)
+}
+
+func TestT(t *testing.T) {
+	assert.Equal(t, "Расчет цикла", Russian.T("Расчет цикла"))
+	assert.Equal(t, "Cycle calculation", English.T("Расчет цикла"))
+	assert.Equal(t, "$m/s$", English.T("$м/с$"))
+
+	// сообщения без перевода выводятся как есть
+	assert.Equal(t, "$\\pi^*$", English.T("$\\pi^*$"))
+}
+
+func TestCurrent(t *testing.T) {
+	defer Set(Current())
+
+	assert.Equal(t, "12,5", Decimal("12.5"))
+	Set(English)
+	assert.Equal(t, "12.5", Decimal("12.5"))
+	assert.Equal(t, "Stage 2", Tf("Ступень %d", 2))
+}
+
+// TestCatalogs проверяет, что перевод строки формата сохраняет число подстановок
+func TestCatalogs(t *testing.T) {
+	for lang, catalog := range catalogs {
+		for msg, translated := range catalog {
+			assert.Equal(t, verbs(msg), verbs(translated), "%s: %q", lang, msg)
+		}
+	}
+}
+
+func verbs(s string) int {
+	var result = 0
+	for i := 0; i+1 < len(s); i++ {
+		if s[i] == '%' {
+			result++
+			i++
+		}
+	}
+	return result
+}
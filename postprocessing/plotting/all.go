@@ -0,0 +1,205 @@
+package plotting
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"gonum.org/v1/plot"
+	"path/filepath"
+)
+
+const (
+	cycleData = "3n.csv"
+
+	cycleEtaPlot         = "cycle_eta_plot"
+	cycleEtaPlotYMin     = 0.65
+	cyclePowerPlot       = "cycle_power_plot"
+	cyclePowerPlotYMin   = 0.6
+	inletAngleData       = "inlet_angle.csv"
+	inletAnglePlot       = "inlet_angle"
+	outletAngleData      = "outlet_angle.csv"
+	outletAnglePlot      = "outlet_angle"
+	coolingNoFrontPS     = "cooling_2_no_front_ps.json"
+	coolingNoFrontSS     = "cooling_2_no_front_ss.json"
+	coolingFrontPS       = "cooling_2_front_ps.json"
+	coolingFrontSS       = "cooling_2_front_ss.json"
+	coolingComplexData   = "cooling_t_complex.csv"
+	coolingEfficiencyCSV = "cooling_t_efficiency.csv"
+
+	coolingTableStep = 5 // в таблицы для построения модели попадает каждая пятая точка
+)
+
+var profilePlots = [][3]string{
+	{"stator_root_1.csv", "stator_root_2.csv", "stator_root"},
+	{"stator_mid_1.csv", "stator_mid_2.csv", "stator_mid"},
+	{"stator_top_1.csv", "stator_top_2.csv", "stator_top"},
+	{"rotor_root_1.csv", "rotor_root_2.csv", "rotor_root"},
+	{"rotor_mid_1.csv", "rotor_mid_2.csv", "rotor_mid"},
+	{"rotor_top_1.csv", "rotor_top_2.csv", "rotor_top"},
+}
+
+// PlotAll строит все рисунки пояснительной записки по данным из dataDir и сохраняет их в imgDir,
+// а также сохраняет в dataDir таблицы температур охлаждаемой лопатки
+func PlotAll(imgDir, dataDir string, opts Options) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	var w = plotWriter{imgDir: imgDir, dataDir: dataDir, opts: opts}
+
+	w.schemeCharacteristics(cycleEtaPlot, MaxEtaCharacteristic, cycleEtaPlotYMin)
+	w.schemeCharacteristics(cyclePowerPlot, MaxPowerCharacteristic, cyclePowerPlotYMin)
+
+	for _, item := range profilePlots {
+		w.profiles(item[2], item[0], item[1])
+	}
+
+	w.angles(inletAnglePlot, inletAngleData, [2]string{"α1", "β1"})
+	w.angles(outletAnglePlot, outletAngleData, [2]string{"α2", "β2"})
+
+	w.cooling("no_front", coolingNoFrontPS, coolingNoFrontSS)
+	w.cooling("front", coolingFrontPS, coolingFrontSS)
+
+	if w.err != nil {
+		return w.err
+	}
+	return w.coolingTables(coolingNoFrontPS, coolingNoFrontSS)
+}
+
+// SaveCoolingTables сохраняет распределения температур и эффективности пленки по обводу профиля (x в мм)
+func SaveCoolingTables(complexPath, efficiencyPath string, ps, ss CoolingData) error {
+	var data = ConcatProfiles(thin(ps, 0), thin(ss, ps.Len()))
+
+	var complexMatrix = make([][]float64, data.Len())
+	var efficiencyMatrix = make([][]float64, data.Len())
+	for i, l := range data.L {
+		var x = l * 1e3
+		complexMatrix[i] = []float64{
+			x, ValueAt(data.TFilm, i), ValueAt(data.TWall, i), ValueAt(data.TAir, i), ValueAt(data.FilmEfficiency, i),
+		}
+		efficiencyMatrix[i] = []float64{x, ValueAt(data.FilmEfficiency, i)}
+	}
+
+	if err := profiling.SaveMatrix(complexPath, complexMatrix); err != nil {
+		return err
+	}
+	return profiling.SaveMatrix(efficiencyPath, efficiencyMatrix)
+}
+
+// plotWriter запоминает первую ошибку, чтобы не проверять ее после построения каждого рисунка
+type plotWriter struct {
+	imgDir  string
+	dataDir string
+	opts    Options
+	err     error
+}
+
+func (w *plotWriter) save(name string, p *plot.Plot, err error) {
+	if w.err != nil {
+		return
+	}
+	if err != nil {
+		w.err = fmt.Errorf("%s: %v", name, err)
+		return
+	}
+	w.err = Save(p, w.imgDir, name, w.opts)
+}
+
+func (w *plotWriter) schemeCharacteristics(
+	name string, selector func([][]float64) (SchemeCharacteristic, error), yMin float64,
+) {
+	if w.err != nil {
+		return
+	}
+	var matrix, err = ReadMatrix(w.dataPath(cycleData))
+	if err != nil {
+		w.save(name, nil, err)
+		return
+	}
+	characteristic, err := selector(matrix)
+	if err != nil {
+		w.save(name, nil, err)
+		return
+	}
+	p, err := SchemeCharacteristics(characteristic, yMin)
+	w.save(name, p, err)
+}
+
+func (w *plotWriter) profiles(name string, dataNames ...string) {
+	if w.err != nil {
+		return
+	}
+	var profiles [][][]float64
+	for _, dataName := range dataNames {
+		var profile, err = ReadMatrix(w.dataPath(dataName))
+		if err != nil {
+			w.save(name, nil, err)
+			return
+		}
+		profiles = append(profiles, profile)
+	}
+	var p, err = Profiles(float64(w.opts.Width/w.opts.Height), profiles...)
+	w.save(name, p, err)
+}
+
+func (w *plotWriter) angles(name, dataName string, names [2]string) {
+	if w.err != nil {
+		return
+	}
+	var data, err = ReadMatrix(w.dataPath(dataName))
+	if err != nil {
+		w.save(name, nil, err)
+		return
+	}
+	p, err := ProfileAngles(data, names)
+	w.save(name, p, err)
+}
+
+func (w *plotWriter) cooling(suffix, psName, ssName string) {
+	if w.err != nil {
+		return
+	}
+	var ps, ss, err = w.readCooling(psName, ssName)
+	if err != nil {
+		w.save("cooling_2_"+suffix, nil, err)
+		return
+	}
+	p, err := CoolingTemperature(ps, ss)
+	w.save("cooling_2_t_"+suffix, p, err)
+	p, err = CoolingAlpha(ps, ss)
+	w.save("cooling_2_alpha_"+suffix, p, err)
+}
+
+func (w *plotWriter) coolingTables(psName, ssName string) error {
+	var ps, ss, err = w.readCooling(psName, ssName)
+	if err != nil {
+		return err
+	}
+	return SaveCoolingTables(w.dataPath(coolingComplexData), w.dataPath(coolingEfficiencyCSV), ps, ss)
+}
+
+func (w *plotWriter) readCooling(psName, ssName string) (CoolingData, CoolingData, error) {
+	var ps, err = ReadCoolingData(w.dataPath(psName))
+	if err != nil {
+		return CoolingData{}, CoolingData{}, err
+	}
+	ss, err := ReadCoolingData(w.dataPath(ssName))
+	if err != nil {
+		return CoolingData{}, CoolingData{}, err
+	}
+	return ps, ss, nil
+}
+
+func (w *plotWriter) dataPath(name string) string {
+	return filepath.Join(w.dataDir, name)
+}
+
+// thin оставляет точки, номер которых в объединенном массиве (с учетом смещения offset) кратен coolingTableStep
+func thin(d CoolingData, offset int) CoolingData {
+	var result CoolingData
+	for i := range d.L {
+		if (i+offset)%coolingTableStep != 0 {
+			continue
+		}
+		result.appendPoint(d, i, 1)
+	}
+	return result
+}
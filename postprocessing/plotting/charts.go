@@ -0,0 +1,285 @@
+package plotting
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/plotter"
+	"gonum.org/v1/plot/vg"
+	"gonum.org/v1/plot/vg/draw"
+	"image/color"
+	"math"
+)
+
+var (
+	black = color.RGBA{A: 255}
+	blue  = color.RGBA{B: 255, A: 255}
+	green = color.RGBA{G: 128, A: 255}
+	red   = color.RGBA{R: 255, A: 255}
+
+	lineWidth  = vg.Points(2)
+	axisWidth  = vg.Points(1)
+	dashLength = []vg.Length{vg.Points(6), vg.Points(2)}
+)
+
+const (
+	schemeMaxPi   = 40 // точки с большей степенью повышения давления не показываются
+	schemeNomPi   = 19 // расчетная степень повышения давления
+	schemeMarker  = 18 // номер отмечаемой точки характеристики
+	schemeYMax    = 1.02
+	coolingMargin = 0.05
+	alphaYMin     = 600
+	alphaYMax     = 2e3
+)
+
+// SchemeCharacteristics строит отнесенные к максимумам расход, удельную работу и КПД схемы
+// в зависимости от суммарной степени повышения давления
+func SchemeCharacteristics(c SchemeCharacteristic, yMin float64) (*plot.Plot, error) {
+	var pi []float64
+	var series = make([][]float64, 3)
+	for i := range c.Pi {
+		if c.Pi[i] > schemeMaxPi {
+			continue
+		}
+		pi = append(pi, c.Pi[i])
+		series[0] = append(series[0], c.MassRate[i])
+		series[1] = append(series[1], c.Power[i])
+		series[2] = append(series[2], c.Eta[i])
+	}
+	if len(pi) == 0 {
+		return nil, fmt.Errorf("no scheme points with pi <= %d", schemeMaxPi)
+	}
+
+	var p = plot.New()
+	p.Add(plotter.NewGrid())
+	p.X.Label.Text = "πΣ"
+	p.Y.Min, p.Y.Max = yMin, schemeYMax
+
+	var names = []string{"G / Gmax", "L / Lmax", "η / ηmax"}
+	var colors = []color.Color{blue, green, red}
+	for i, values := range series {
+		var xys = normalizedXYs(pi, values)
+		var line, err = newLine(xys, colors[i], nil)
+		if err != nil {
+			return nil, err
+		}
+		p.Add(line)
+		p.Legend.Add(names[i], line)
+
+		if schemeMarker < len(xys) {
+			var marker, err = plotter.NewScatter(xys[schemeMarker : schemeMarker+1])
+			if err != nil {
+				return nil, err
+			}
+			marker.GlyphStyle.Shape = draw.CircleGlyph{}
+			marker.GlyphStyle.Color = colors[i]
+			marker.GlyphStyle.Radius = vg.Points(3)
+			p.Add(marker)
+		}
+	}
+
+	var nom, err = newLine(plotter.XYs{{X: schemeNomPi, Y: yMin}, {X: schemeNomPi, Y: schemeYMax}}, black, nil)
+	if err != nil {
+		return nil, err
+	}
+	nom.Width = axisWidth
+	p.Add(nom)
+
+	p.Legend.Top = false
+	p.Legend.Left = false
+	return p, nil
+}
+
+// Profiles строит контуры профилей в одинаковом масштабе по осям для рисунка с отношением сторон aspect
+func Profiles(aspect float64, profiles ...[][]float64) (*plot.Plot, error) {
+	var p = plot.New()
+	p.Add(plotter.NewGrid())
+
+	var xMin, xMax, yMin, yMax = math.Inf(1), math.Inf(-1), math.Inf(1), math.Inf(-1)
+	for _, profile := range profiles {
+		var xys = make(plotter.XYs, len(profile))
+		for i, point := range profile {
+			if len(point) < 2 {
+				return nil, fmt.Errorf("profile point %d has %d coordinates", i, len(point))
+			}
+			xys[i].X, xys[i].Y = point[0], point[1]
+			xMin, xMax = math.Min(xMin, point[0]), math.Max(xMax, point[0])
+			yMin, yMax = math.Min(yMin, point[1]), math.Max(yMax, point[1])
+		}
+		var line, err = newLine(xys, blue, nil)
+		if err != nil {
+			return nil, err
+		}
+		line.Width = axisWidth
+		p.Add(line)
+	}
+
+	p.X.Min, p.X.Max, p.Y.Min, p.Y.Max = equalAxes(xMin, xMax, yMin, yMax, aspect)
+	return p, nil
+}
+
+// ProfileAngles строит распределение углов потока по относительной высоте лопатки.
+// Строки data: относительная высота, угол в абсолютном движении, угол в относительном движении (рад).
+func ProfileAngles(data [][]float64, names [2]string) (*plot.Plot, error) {
+	var p = plot.New()
+	p.Add(plotter.NewGrid())
+	p.Y.Label.Text = "h"
+
+	var colors = []color.Color{blue, green}
+	for j := 0; j != 2; j++ {
+		var xys = make(plotter.XYs, len(data))
+		for i, row := range data {
+			if len(row) < 3 {
+				return nil, fmt.Errorf("angle row %d has %d columns", i, len(row))
+			}
+			xys[i].X, xys[i].Y = row[j+1]*180/math.Pi, row[0]
+		}
+		var line, err = newLine(xys, colors[j], nil)
+		if err != nil {
+			return nil, err
+		}
+		p.Add(line)
+		p.Legend.Add(names[j], line)
+	}
+	p.Legend.Top = true
+	return p, nil
+}
+
+// CoolingTemperature строит распределение температур воздуха, стенки и пленки по обводу профиля.
+// Спинке соответствуют отрицательные координаты.
+func CoolingTemperature(ps, ss CoolingData) (*plot.Plot, error) {
+	var data = ConcatProfiles(ps, ss)
+	if data.Len() == 0 {
+		return nil, fmt.Errorf("empty cooling data")
+	}
+
+	var p = plot.New()
+	p.Add(plotter.NewGrid())
+	p.X.Label.Text = locale.T("x, мм")
+	p.Y.Label.Text = locale.T("T, К")
+
+	var tMin, tMax = minOf(data.TAir), math.Max(maxOf(data.TFilm), maxOf(data.TWall))
+	tMax += (tMax - tMin) * coolingMargin
+	p.Y.Min, p.Y.Max = tMin, tMax
+
+	for _, item := range []struct {
+		name   string
+		values []float64
+		color  color.Color
+		dashes []vg.Length
+	}{
+		{locale.T("Tст пр"), data.TWall, green, nil},
+		{locale.T("Tв"), data.TAir, blue, nil},
+		{locale.T("Tпл"), data.TFilm, red, nil},
+		{locale.T("Tст"), data.TWallSmooth, green, dashLength},
+	} {
+		if err := addCoolingLine(p, data.L, item.values, item.name, item.color, item.dashes); err != nil {
+			return nil, err
+		}
+	}
+	if err := addZeroLine(p, data.L, tMin, tMax); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// CoolingAlpha строит распределение коэффициентов теплоотдачи со стороны воздуха и газа по обводу профиля
+func CoolingAlpha(ps, ss CoolingData) (*plot.Plot, error) {
+	var data = ConcatProfiles(ps, ss)
+	if data.Len() == 0 {
+		return nil, fmt.Errorf("empty cooling data")
+	}
+
+	var p = plot.New()
+	p.Add(plotter.NewGrid())
+	p.X.Label.Text = locale.T("x, мм")
+	p.Y.Min, p.Y.Max = alphaYMin, alphaYMax
+
+	if err := addCoolingLine(p, data.L, data.AlphaAir, locale.T("αв"), blue, nil); err != nil {
+		return nil, err
+	}
+	if err := addCoolingLine(p, data.L, data.AlphaGas, locale.T("αпл"), red, nil); err != nil {
+		return nil, err
+	}
+	if err := addZeroLine(p, data.L, alphaYMin, alphaYMax); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// addCoolingLine пропускает величины, отсутствующие в решении
+func addCoolingLine(p *plot.Plot, l, values []float64, name string, c color.Color, dashes []vg.Length) error {
+	if len(values) == 0 {
+		return nil
+	}
+	var xys = make(plotter.XYs, len(l))
+	for i := range l {
+		xys[i].X, xys[i].Y = l[i]*1e3, ValueAt(values, i)
+	}
+	var line, err = newLine(xys, c, dashes)
+	if err != nil {
+		return err
+	}
+	p.Add(line)
+	p.Legend.Add(name, line)
+	return nil
+}
+
+// addZeroLine отмечает входную кромку и ограничивает ось x длиной обвода
+func addZeroLine(p *plot.Plot, l []float64, yMin, yMax float64) error {
+	var line, err = newLine(plotter.XYs{{X: 0, Y: yMin}, {X: 0, Y: yMax}}, black, nil)
+	if err != nil {
+		return err
+	}
+	line.Width = axisWidth
+	p.Add(line)
+	p.X.Min, p.X.Max = minOf(l)*1e3, maxOf(l)*1e3
+	return nil
+}
+
+func newLine(xys plotter.XYs, c color.Color, dashes []vg.Length) (*plotter.Line, error) {
+	var line, err = plotter.NewLine(xys)
+	if err != nil {
+		return nil, err
+	}
+	line.Color = c
+	line.Width = lineWidth
+	line.Dashes = dashes
+	return line, nil
+}
+
+func normalizedXYs(x, y []float64) plotter.XYs {
+	var yMax = maxOf(y)
+	var result = make(plotter.XYs, len(x))
+	for i := range x {
+		result[i].X, result[i].Y = x[i], y[i]/yMax
+	}
+	return result
+}
+
+// equalAxes расширяет меньший из диапазонов так, чтобы единица длины по обеим осям была одинаковой
+func equalAxes(xMin, xMax, yMin, yMax, aspect float64) (float64, float64, float64, float64) {
+	var dx, dy = xMax - xMin, yMax - yMin
+	if dx/dy > aspect {
+		var extra = (dx/aspect - dy) / 2
+		return xMin, xMax, yMin - extra, yMax + extra
+	}
+	var extra = (dy*aspect - dx) / 2
+	return xMin - extra, xMax + extra, yMin, yMax
+}
+
+func minOf(arr []float64) float64 {
+	var result = math.Inf(1)
+	for _, v := range arr {
+		result = math.Min(result, v)
+	}
+	return result
+}
+
+func maxOf(arr []float64) float64 {
+	var result = math.Inf(-1)
+	for _, v := range arr {
+		result = math.Max(result, v)
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package plotting
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gonum.org/v1/plot"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCommaTicker(t *testing.T) {
+	var ticker = commaTicker{plot.DefaultTicks{}}
+	var hasComma = false
+	for _, tick := range ticker.Ticks(0, 1) {
+		assert.NotContains(t, tick.Label, ".")
+		if len(tick.Label) > 1 {
+			hasComma = true
+		}
+	}
+	assert.True(t, hasComma)
+}
+
+func TestEqualAxes(t *testing.T) {
+	var xMin, xMax, yMin, yMax = equalAxes(0, 4, 0, 1, 2)
+	assert.Equal(t, []float64{0, 4, -0.5, 1.5}, []float64{xMin, xMax, yMin, yMax})
+
+	xMin, xMax, yMin, yMax = equalAxes(0, 1, 0, 4, 1)
+	assert.Equal(t, []float64{-1.5, 2.5, 0, 4}, []float64{xMin, xMax, yMin, yMax})
+}
+
+func TestCharts_Save(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "plotting")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var opts = DefaultOptions()
+	opts.Formats = []string{PNG, PDF, SVG}
+
+	var characteristic SchemeCharacteristic
+	for i := 0; i != 30; i++ {
+		var pi = 5 + float64(i)
+		characteristic.Pi = append(characteristic.Pi, pi)
+		characteristic.MassRate = append(characteristic.MassRate, 60-pi)
+		characteristic.Power = append(characteristic.Power, 300+10*pi-0.2*pi*pi)
+		characteristic.Eta = append(characteristic.Eta, 0.3+0.01*pi-0.0002*pi*pi)
+	}
+	p, err := SchemeCharacteristics(characteristic, 0.6)
+	assert.Nil(t, err)
+	assert.Nil(t, Save(p, dir, "cycle", opts))
+
+	var profile [][]float64
+	for i := 0; i <= 20; i++ {
+		var phi = math.Pi * float64(i) / 20
+		profile = append(profile, []float64{math.Cos(phi), 0.2 * math.Sin(phi)})
+	}
+	p, err = Profiles(4./3, profile)
+	assert.Nil(t, err)
+	assert.Nil(t, Save(p, dir, "profile", opts))
+
+	p, err = ProfileAngles([][]float64{{0, 0.3, 0.5}, {1, 0.4, 0.7}}, [2]string{"α1", "β1"})
+	assert.Nil(t, err)
+	assert.Nil(t, Save(p, dir, "angles", opts))
+
+	var ps = CoolingData{
+		L: []float64{0, 0.01, 0.02}, TAir: []float64{600, 650, 700}, TFilm: []float64{1300, 1250, 1200},
+		TWall: []float64{900, 950, 1000}, TWallSmooth: []float64{910, 950, 990},
+		AlphaAir: []float64{1000, 1100, 1200}, AlphaGas: []float64{1500, 1400, 1300},
+	}
+	p, err = CoolingTemperature(ps, ps)
+	assert.Nil(t, err)
+	assert.Nil(t, Save(p, dir, "cooling_t", opts))
+	p, err = CoolingAlpha(ps, ps)
+	assert.Nil(t, err)
+	assert.Nil(t, Save(p, dir, "cooling_alpha", opts))
+
+	for _, name := range []string{"cycle", "profile", "angles", "cooling_t", "cooling_alpha"} {
+		for _, format := range opts.Formats {
+			var info, err = os.Stat(filepath.Join(dir, name+"."+format))
+			assert.Nil(t, err)
+			assert.True(t, info != nil && info.Size() > 0, name+"."+format)
+		}
+	}
+
+	_, err = CoolingTemperature(CoolingData{}, CoolingData{})
+	assert.Error(t, err)
+}
+
+func TestOptions_Validate(t *testing.T) {
+	assert.Nil(t, DefaultOptions().Validate())
+
+	var opts = DefaultOptions()
+	opts.Formats = []string{"jpg"}
+	assert.Error(t, opts.Validate())
+
+	opts = DefaultOptions()
+	opts.Width = 0
+	assert.Error(t, opts.Validate())
+}
@@ -0,0 +1,179 @@
+package plotting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// столбцы файла характеристик трехвальной схемы (см. core.DoubleCompressorDataPoint.ToArray)
+const (
+	piCol       = 0
+	piFactorCol = 1
+	massRateCol = 2
+	powerCol    = 3
+	etaCol      = 4
+)
+
+func ReadMatrix(path string) ([][]float64, error) {
+	var file, err = os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reader = csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	var result = make([][]float64, len(records))
+	for i, record := range records {
+		result[i] = make([]float64, len(record))
+		for j, field := range record {
+			if result[i][j], err = strconv.ParseFloat(field, 64); err != nil {
+				return nil, fmt.Errorf("%s: line %d: %v", path, i+1, err)
+			}
+		}
+	}
+	return result, nil
+}
+
+// CoolingData - решение задачи охлаждения вдоль одной стороны профиля
+type CoolingData struct {
+	L              []float64 `json:"l"`
+	AlphaAir       []float64 `json:"alpha_air"`
+	AlphaGas       []float64 `json:"alpha_gas"`
+	TAir           []float64 `json:"t_air"`
+	TWall          []float64 `json:"t_wall"`
+	TWallSmooth    []float64 `json:"t_wall_smooth"`
+	TFilm          []float64 `json:"t_film"`
+	FilmEfficiency []float64 `json:"film_efficiency"`
+}
+
+func ReadCoolingData(path string) (CoolingData, error) {
+	var b, err = ioutil.ReadFile(path)
+	if err != nil {
+		return CoolingData{}, err
+	}
+	var result CoolingData
+	if err := json.Unmarshal(b, &result); err != nil {
+		return CoolingData{}, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return result, nil
+}
+
+func (d CoolingData) Len() int {
+	return len(d.L)
+}
+
+// ConcatProfiles объединяет решения для корытца и спинки в одну кривую по обводу профиля:
+// координата спинки берется со знаком минус. Величины, отсутствующие в обоих решениях, остаются пустыми.
+func ConcatProfiles(ps, ss CoolingData) CoolingData {
+	var result CoolingData
+	for i := range ps.L {
+		result.appendPoint(ps, i, 1)
+	}
+	for i := range ss.L {
+		result.appendPoint(ss, i, -1)
+	}
+	sort.Stable(byL(result))
+
+	var resultFields, psFields, ssFields = result.fields(), ps.fields(), ss.fields()
+	for i := range resultFields {
+		if len(*psFields[i]) == 0 && len(*ssFields[i]) == 0 {
+			*resultFields[i] = nil
+		}
+	}
+	return result
+}
+
+func (d *CoolingData) fields() []*[]float64 {
+	return []*[]float64{
+		&d.AlphaAir, &d.AlphaGas, &d.TAir, &d.TWall, &d.TWallSmooth, &d.TFilm, &d.FilmEfficiency,
+	}
+}
+
+func (d *CoolingData) appendPoint(src CoolingData, i int, sign float64) {
+	d.L = append(d.L, sign*src.L[i])
+	d.AlphaAir = append(d.AlphaAir, ValueAt(src.AlphaAir, i))
+	d.AlphaGas = append(d.AlphaGas, ValueAt(src.AlphaGas, i))
+	d.TAir = append(d.TAir, ValueAt(src.TAir, i))
+	d.TWall = append(d.TWall, ValueAt(src.TWall, i))
+	d.TWallSmooth = append(d.TWallSmooth, ValueAt(src.TWallSmooth, i))
+	d.TFilm = append(d.TFilm, ValueAt(src.TFilm, i))
+	d.FilmEfficiency = append(d.FilmEfficiency, ValueAt(src.FilmEfficiency, i))
+}
+
+// SchemeCharacteristic - зависимость параметров схемы от суммарной степени повышения давления
+// при фиксированном распределении ее между компрессорами
+type SchemeCharacteristic struct {
+	PiFactor float64
+	Pi       []float64
+	MassRate []float64
+	Power    []float64
+	Eta      []float64
+}
+
+// MaxEtaCharacteristic выбирает распределение степени повышения давления, при котором достигается наибольший КПД
+func MaxEtaCharacteristic(matrix [][]float64) (SchemeCharacteristic, error) {
+	return maxCharacteristic(matrix, etaCol)
+}
+
+// MaxPowerCharacteristic выбирает распределение степени повышения давления, при котором достигается наибольшая удельная работа
+func MaxPowerCharacteristic(matrix [][]float64) (SchemeCharacteristic, error) {
+	return maxCharacteristic(matrix, powerCol)
+}
+
+func maxCharacteristic(matrix [][]float64, col int) (SchemeCharacteristic, error) {
+	if len(matrix) == 0 {
+		return SchemeCharacteristic{}, fmt.Errorf("empty scheme data")
+	}
+	var best = 0
+	for i, row := range matrix {
+		if len(row) <= etaCol {
+			return SchemeCharacteristic{}, fmt.Errorf("line %d: expected at least %d columns, got %d", i+1, etaCol+1, len(row))
+		}
+		if row[col] > matrix[best][col] {
+			best = i
+		}
+	}
+
+	var result = SchemeCharacteristic{PiFactor: matrix[best][piFactorCol]}
+	for _, row := range matrix {
+		if row[piFactorCol] != result.PiFactor {
+			continue
+		}
+		result.Pi = append(result.Pi, row[piCol])
+		result.MassRate = append(result.MassRate, row[massRateCol])
+		result.Power = append(result.Power, row[powerCol])
+		result.Eta = append(result.Eta, row[etaCol])
+	}
+	return result, nil
+}
+
+// ValueAt возвращает i-й элемент arr или 0, если величина рассчитана не во всех точках
+func ValueAt(arr []float64, i int) float64 {
+	if i < len(arr) {
+		return arr[i]
+	}
+	return 0
+}
+
+type byL CoolingData
+
+func (d byL) Len() int           { return len(d.L) }
+func (d byL) Less(i, j int) bool { return d.L[i] < d.L[j] }
+func (d byL) Swap(i, j int) {
+	for _, arr := range [][]float64{
+		d.L, d.AlphaAir, d.AlphaGas, d.TAir, d.TWall, d.TWallSmooth, d.TFilm, d.FilmEfficiency,
+	} {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
@@ -0,0 +1,97 @@
+package plotting
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadMatrix(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "plotting")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var path = filepath.Join(dir, "m.csv")
+	var matrix = [][]float64{{1, 2.5}, {3, -4e-3}}
+	assert.Nil(t, profiling.SaveMatrix(path, matrix))
+
+	read, err := ReadMatrix(path)
+	assert.Nil(t, err)
+	assert.Equal(t, matrix, read)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("1,a\n"), 0644))
+	_, err = ReadMatrix(path)
+	assert.Error(t, err)
+}
+
+func TestMaxCharacteristics(t *testing.T) {
+	// pi, pi_factor, G, N_e, eta
+	var matrix = [][]float64{
+		{10, 0.3, 50, 300e3, 0.36},
+		{20, 0.3, 40, 320e3, 0.38},
+		{10, 0.5, 48, 310e3, 0.37},
+		{20, 0.5, 38, 330e3, 0.39},
+		{10, 0.7, 47, 340e3, 0.35},
+		{20, 0.7, 37, 335e3, 0.36},
+	}
+
+	var eta, err = MaxEtaCharacteristic(matrix)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, eta.PiFactor)
+	assert.Equal(t, []float64{10, 20}, eta.Pi)
+	assert.Equal(t, []float64{0.37, 0.39}, eta.Eta)
+
+	power, err := MaxPowerCharacteristic(matrix)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.7, power.PiFactor)
+	assert.Equal(t, []float64{47, 37}, power.MassRate)
+
+	_, err = MaxEtaCharacteristic(nil)
+	assert.Error(t, err)
+	_, err = MaxEtaCharacteristic([][]float64{{1, 2}})
+	assert.Error(t, err)
+}
+
+func TestConcatProfiles(t *testing.T) {
+	var ps = CoolingData{L: []float64{0, 1, 2}, TAir: []float64{10, 11, 12}}
+	var ss = CoolingData{L: []float64{0, 1}, TAir: []float64{20, 21}}
+
+	var data = ConcatProfiles(ps, ss)
+	assert.Equal(t, []float64{-1, 0, 0, 1, 2}, data.L)
+	assert.Equal(t, []float64{21, 10, 20, 11, 12}, data.TAir)
+	assert.Nil(t, data.TFilm)
+
+	ss.TFilm = []float64{30, 31}
+	data = ConcatProfiles(ps, ss)
+	assert.Equal(t, []float64{31, 0, 30, 0, 0}, data.TFilm)
+}
+
+func TestSaveCoolingTables(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "plotting")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	var ps, ss CoolingData
+	for i := 0; i != 7; i++ {
+		ps.L = append(ps.L, float64(i)*1e-3)
+		ps.FilmEfficiency = append(ps.FilmEfficiency, float64(i))
+		ss.L = append(ss.L, float64(i)*1e-3)
+		ss.FilmEfficiency = append(ss.FilmEfficiency, float64(10+i))
+	}
+
+	var complexPath, efficiencyPath = filepath.Join(dir, "c.csv"), filepath.Join(dir, "e.csv")
+	assert.Nil(t, SaveCoolingTables(complexPath, efficiencyPath, ps, ss))
+
+	efficiency, err := ReadMatrix(efficiencyPath)
+	assert.Nil(t, err)
+	// точки 0, 5 корытца и 10 - 7 = 3 спинки
+	assert.Equal(t, [][]float64{{-3, 13}, {0, 0}, {5, 5}}, efficiency)
+
+	complexData, err := ReadMatrix(complexPath)
+	assert.Nil(t, err)
+	assert.Len(t, complexData, 3)
+	assert.Len(t, complexData[0], 5)
+}
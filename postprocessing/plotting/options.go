@@ -0,0 +1,90 @@
+package plotting
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"gonum.org/v1/plot"
+	"gonum.org/v1/plot/vg"
+	"path/filepath"
+)
+
+const (
+	PNG = "png"
+	PDF = "pdf"
+	SVG = "svg"
+)
+
+type Options struct {
+	Width  vg.Length
+	Height vg.Length
+
+	// DecimalComma заменяет десятичную точку в подписях осей на запятую
+	DecimalComma bool
+	// Formats - расширения, в которых сохраняется каждый рисунок
+	Formats []string
+}
+
+// DefaultOptions соответствуют рисункам, которые строил plot_all.py;
+// десятичный разделитель в подписях осей определяется текущим языком
+func DefaultOptions() Options {
+	return Options{
+		Width:        16 * vg.Centimeter,
+		Height:       12 * vg.Centimeter,
+		DecimalComma: locale.Current().DecimalSeparator() == ",",
+		Formats:      []string{PNG},
+	}
+}
+
+func (opts Options) Validate() error {
+	if opts.Width <= 0 || opts.Height <= 0 {
+		return fmt.Errorf("plot size must be positive")
+	}
+	if len(opts.Formats) == 0 {
+		return fmt.Errorf("no output formats specified")
+	}
+	for _, format := range opts.Formats {
+		if format != PNG && format != PDF && format != SVG {
+			return fmt.Errorf("unsupported plot format %q", format)
+		}
+	}
+	return nil
+}
+
+// EncodeSVG возвращает рисунок в формате SVG для встраивания в другие документы
+func EncodeSVG(p *plot.Plot, opts Options) ([]byte, error) {
+	applyLocale(p, opts)
+	var writer, err = p.WriterTo(opts.Width, opts.Height, SVG)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if _, err := writer.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Save сохраняет рисунок в каталог dir под именем name во всех форматах opts.Formats
+func Save(p *plot.Plot, dir, name string, opts Options) error {
+	applyLocale(p, opts)
+	for _, format := range opts.Formats {
+		var path = filepath.Join(dir, name+"."+format)
+		if err := p.Save(opts.Width, opts.Height, path); err != nil {
+			return fmt.Errorf("failed to save %s: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func applyLocale(p *plot.Plot, opts Options) {
+	if !opts.DecimalComma {
+		return
+	}
+	if _, ok := p.X.Tick.Marker.(commaTicker); !ok {
+		p.X.Tick.Marker = commaTicker{p.X.Tick.Marker}
+	}
+	if _, ok := p.Y.Tick.Marker.(commaTicker); !ok {
+		p.Y.Tick.Marker = commaTicker{p.Y.Tick.Marker}
+	}
+}
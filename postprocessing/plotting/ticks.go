@@ -0,0 +1,18 @@
+package plotting
+
+import (
+	"gonum.org/v1/plot"
+	"strings"
+)
+
+type commaTicker struct {
+	plot.Ticker
+}
+
+func (t commaTicker) Ticks(min, max float64) []plot.Tick {
+	var ticks = t.Ticker.Ticks(min, max)
+	for i := range ticks {
+		ticks[i].Label = strings.Replace(ticks[i].Label, ".", ",", -1)
+	}
+	return ticks
+}
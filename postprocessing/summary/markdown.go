@@ -0,0 +1,166 @@
+package summary
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"io"
+	"os"
+	"strings"
+)
+
+// Summary - краткая сводка результатов для обсуждения вариантов без полной пояснительной записки
+type Summary struct {
+	Title   string
+	Cycle   Cycle
+	Spools  []Spool
+	Cooling Cooling
+}
+
+type Cycle struct {
+	Scheme         string
+	Ne             float64
+	Eta            float64
+	SpecificPower  float64
+	MassRate       float64
+	FuelMassRate   float64
+	Ce             float64
+	TGas           float64
+	PiLowPressure  float64
+	PiHighPressure float64
+}
+
+type Spool struct {
+	Name string
+	Rows []dataframes.StageRow
+}
+
+type Cooling struct {
+	TGas         float64
+	TAirInlet    float64
+	TWallAllowed float64
+	PS           SideCooling
+	SS           SideCooling
+}
+
+type SideCooling struct {
+	TWallMax   float64
+	TAirOutlet float64
+}
+
+// Margin - запас по температуре стенки; отрицательное значение означает перегрев
+func (c Cooling) Margin(side SideCooling) float64 {
+	return c.TWallAllowed - side.TWallMax
+}
+
+func (s Summary) Save(path string) error {
+	var file, err = os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return s.Write(file)
+}
+
+func (s Summary) Write(w io.Writer) error {
+	var buf = bufio.NewWriter(w)
+
+	fmt.Fprintf(buf, "# %s\n\n", s.Title)
+	s.writeCycle(buf)
+	s.writeSpools(buf)
+	s.writeCooling(buf)
+
+	return buf.Flush()
+}
+
+func (s Summary) writeCycle(w io.Writer) {
+	var c = s.Cycle
+	fmt.Fprintf(w, "## %s\n\n", locale.T("Цикл"))
+	if c.Scheme != "" {
+		fmt.Fprintf(w, "%s\n\n", locale.Tf("Схема: %s", c.Scheme))
+	}
+	writeTable(w, translated("Параметр", "Значение", "Размерность"), [][]string{
+		{locale.T("Мощность $N_e$"), dataframes.Round2(dataframes.DivideE6(c.Ne)), locale.T("МВт")},
+		{locale.T("КПД $\\eta_e$"), dataframes.Round3(c.Eta), "-"},
+		{locale.T("Удельная работа $L_e$"), dataframes.Round1(dataframes.DivideE3(c.SpecificPower)), locale.T("кДж/кг")},
+		{locale.T("Расход воздуха $G_в$"), dataframes.Round2(c.MassRate), locale.T("кг/с")},
+		{locale.T("Расход топлива $G_т$"), dataframes.Round3(c.FuelMassRate), locale.T("кг/с")},
+		{locale.T("Удельный расход топлива $C_e$"), "$" + dataframes.Round3(dataframes.MultiplyE3(c.Ce)) + " \\cdot 10^{-3}$", locale.T("кг/(кВт ч)")},
+		{locale.T("Температура газа $T_г^*$"), dataframes.Round(c.TGas), locale.T("К")},
+		{locale.T("Степень повышения давления КНД $\\pi_{кнд}^*$"), dataframes.Round2(c.PiLowPressure), "-"},
+		{locale.T("Степень повышения давления КВД $\\pi_{квд}^*$"), dataframes.Round2(c.PiHighPressure), "-"},
+	})
+}
+
+func (s Summary) writeSpools(w io.Writer) {
+	if len(s.Spools) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "## %s\n\n", locale.T("Ступени"))
+	for _, spool := range s.Spools {
+		fmt.Fprintf(w, "### %s\n\n", spool.Name)
+		var header = translated("№", "Параметр", "Размерность")
+		if len(spool.Rows) > 0 {
+			for i := range spool.Rows[0].Values {
+				header = append(header, fmt.Sprint(i+1))
+			}
+		}
+		var rows = make([][]string, len(spool.Rows))
+		for i, row := range spool.Rows {
+			rows[i] = append([]string{fmt.Sprint(row.ID), locale.T(row.Name), locale.T(row.Dimension)}, row.Strings()...)
+		}
+		writeTable(w, header, rows)
+	}
+}
+
+func (s Summary) writeCooling(w io.Writer) {
+	var c = s.Cooling
+	fmt.Fprintf(w, "## %s\n\n", locale.T("Охлаждение"))
+	fmt.Fprintf(w, "%s\n\n", locale.Tf(
+		"$T_г = %s$ К, $\\theta_0 = %s$ К, допустимая температура стенки $T_{ст} = %s$ К.",
+		dataframes.Round(c.TGas), dataframes.Round(c.TAirInlet), dataframes.Round(c.TWallAllowed),
+	))
+	writeTable(w, translated("Параметр", "Корытце", "Спинка"), [][]string{
+		{locale.T("$T_{ст\\ max}$, К"), dataframes.Round(c.PS.TWallMax), dataframes.Round(c.SS.TWallMax)},
+		{locale.T("Запас $T_{ст} - T_{ст\\ max}$, К"), margin(c.Margin(c.PS)), margin(c.Margin(c.SS))},
+		{locale.T("$T_в$ на выходе, К"), dataframes.Round(c.PS.TAirOutlet), dataframes.Round(c.SS.TAirOutlet)},
+	})
+}
+
+// margin выделяет перегрев, чтобы он был заметен при просмотре сводки
+func margin(value float64) string {
+	if value < 0 {
+		return "**" + dataframes.Round(value) + "**"
+	}
+	return dataframes.Round(value)
+}
+
+func translated(msgs ...string) []string {
+	var result = make([]string, len(msgs))
+	for i, msg := range msgs {
+		result[i] = locale.T(msg)
+	}
+	return result
+}
+
+func writeTable(w io.Writer, header []string, rows [][]string) {
+	writeTableRow(w, header)
+	var separator = make([]string, len(header))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeTableRow(w, separator)
+	for _, row := range rows {
+		writeTableRow(w, row)
+	}
+	fmt.Fprintln(w)
+}
+
+func writeTableRow(w io.Writer, cells []string) {
+	var escaped = make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.Replace(cell, "|", "\\|", -1)
+	}
+	fmt.Fprintf(w, "| %s |\n", strings.Join(escaped, " | "))
+}
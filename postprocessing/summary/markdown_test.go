@@ -0,0 +1,90 @@
+package summary
+
+import (
+	"bytes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func testSummary() Summary {
+	return Summary{
+		Title: "Вариант 1",
+		Cycle: Cycle{
+			Scheme: "3n", Ne: 16e6, Eta: 0.389, SpecificPower: 350e3,
+			MassRate: 45.7, FuelMassRate: 0.912, Ce: 0.217e-3, TGas: 1450,
+			PiLowPressure: 4, PiHighPressure: 5,
+		},
+		Spools: []Spool{{
+			Name: "КНД",
+			Rows: []dataframes.StageRow{
+				dataframes.StageRow{ID: 1, Name: "$\\pi^*$", Dimension: "-", Values: []float64{1.5, 1.45}}.FormatString(dataframes.Round2),
+				dataframes.StageRow{ID: 2, Name: "a|b", Dimension: "м", Values: []float64{1, 2}}.FormatString(dataframes.Round),
+			},
+		}},
+		Cooling: Cooling{
+			TGas: 1450, TAirInlet: 700, TWallAllowed: 1000,
+			PS: SideCooling{TWallMax: 980, TAirOutlet: 850},
+			SS: SideCooling{TWallMax: 1010, TAirOutlet: 870},
+		},
+	}
+}
+
+func TestSummary_Write(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, testSummary().Write(&buf))
+	var md = buf.String()
+
+	assert.True(t, strings.HasPrefix(md, "# Вариант 1\n\n## Цикл\n\nСхема: 3n\n"))
+	assert.Contains(t, md, "| Параметр | Значение | Размерность |\n| --- | --- | --- |\n")
+	assert.Contains(t, md, "| Мощность $N_e$ | 16,00 | МВт |")
+	assert.Contains(t, md, "| КПД $\\eta_e$ | 0,389 | - |")
+	assert.Contains(t, md, "| Удельная работа $L_e$ | 350,0 | кДж/кг |")
+	assert.Contains(t, md, "| Расход топлива $G_т$ | 0,912 | кг/с |")
+
+	assert.Contains(t, md, "### КНД\n\n| № | Параметр | Размерность | 1 | 2 |\n")
+	assert.Contains(t, md, "| 1 | $\\pi^*$ | - | 1,50 | 1,45 |")
+	assert.Contains(t, md, "| 2 | a\\|b | м | 1 | 2 |")
+
+	assert.Contains(t, md, "| Запас $T_{ст} - T_{ст\\ max}$, К | 20 | **-10** |")
+	assert.Contains(t, md, "| $T_в$ на выходе, К | 850 | 870 |")
+}
+
+func TestSummary_Write_English(t *testing.T) {
+	defer locale.Set(locale.Current())
+	locale.Set(locale.English)
+
+	var buf bytes.Buffer
+	assert.NoError(t, testSummary().Write(&buf))
+	var md = buf.String()
+
+	assert.True(t, strings.HasPrefix(md, "# Вариант 1\n\n## Cycle\n\nScheme: 3n\n"))
+	assert.Contains(t, md, "| Power $N_e$ | 16.00 | MW |")
+	assert.Contains(t, md, "| No. | Parameter | Unit | 1 | 2 |\n")
+	assert.Contains(t, md, "| 2 | a\\|b | m | 1 | 2 |")
+	assert.Contains(t, md, "| Margin $T_w - T_{w\\ max}$, K | 20 | **-10** |")
+}
+
+func TestCooling_Margin(t *testing.T) {
+	var c = testSummary().Cooling
+	assert.Equal(t, 20., c.Margin(c.PS))
+	assert.Equal(t, -10., c.Margin(c.SS))
+}
+
+func TestNewCooling_MaterialLimit(t *testing.T) {
+	var gapDF dataframes.GapCalcDF
+	gapDF.Gas.Tg = 1450
+	gapDF.Metal.TWallOuter = 1000
+
+	var profileDF dataframes.TProfileCalcDF
+	profileDF.Gas.TWallPSArr = []float64{1050, 1150, 1100}
+	profileDF.Gas.TAirPSArr = []float64{700, 750, 800}
+
+	var c = NewCooling(gapDF, profileDF, 1273)
+	assert.Equal(t, 1273., c.TWallAllowed)
+	assert.Equal(t, 1150., c.PS.TWallMax)
+	assert.Equal(t, 800., c.PS.TAirOutlet)
+	assert.Equal(t, 123., c.Margin(c.PS))
+}
@@ -0,0 +1,69 @@
+package summary
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+)
+
+// New собирает сводку по решенной схеме, ступенчатым моделям машин и результатам расчета охлаждения
+func New(
+	title string,
+	cycle dataframes.ThreeShaftsDF,
+	machines *midall.StagedScheme3n,
+	gapDF dataframes.GapCalcDF,
+	profileDF dataframes.TProfileCalcDF,
+	tWallMax float64,
+) Summary {
+	return Summary{
+		Title: title,
+		Cycle: NewCycle(cycle),
+		Spools: []Spool{
+			{Name: locale.T("КНД"), Rows: dataframes.NewStagedCompressorDF(machines.LPC).Rows()},
+			{Name: locale.T("КВД"), Rows: dataframes.NewStagedCompressorDF(machines.HPC).Rows()},
+			{Name: locale.T("ТВД"), Rows: dataframes.NewStagedTurbineDF(machines.HPT).Rows()},
+			{Name: locale.T("ТНД"), Rows: dataframes.NewStagedTurbineDF(machines.LPT).Rows()},
+			{Name: locale.T("СТ"), Rows: dataframes.NewStagedTurbineDF(machines.FT).Rows()},
+		},
+		Cooling: NewCooling(gapDF, profileDF, tWallMax),
+	}
+}
+
+func NewCycle(df dataframes.ThreeShaftsDF) Cycle {
+	return Cycle{
+		Scheme:         df.Title(),
+		Ne:             df.Ne,
+		Eta:            df.Eta,
+		SpecificPower:  float64(df.EngineLabour),
+		MassRate:       float64(df.MassRate),
+		FuelMassRate:   float64(df.MassRate) * df.Burner.FuelMassRateRel,
+		Ce:             df.Ce,
+		TGas:           float64(df.Burner.Tg),
+		PiLowPressure:  df.LPCompressor.Pi,
+		PiHighPressure: df.HPCompressor.Pi,
+	}
+}
+
+// NewCooling сравнивает расчетные температуры стенки по обводу профиля с допустимой температурой материала tWallMax
+func NewCooling(gapDF dataframes.GapCalcDF, profileDF dataframes.TProfileCalcDF, tWallMax float64) Cooling {
+	return Cooling{
+		TGas:         gapDF.Gas.Tg,
+		TAirInlet:    gapDF.Gas.Theta0,
+		TWallAllowed: tWallMax,
+		PS:           newSideCooling(profileDF.Gas.TWallPSArr, profileDF.Gas.TAirPSArr),
+		SS:           newSideCooling(profileDF.Gas.TWallSSArr, profileDF.Gas.TAirSSArr),
+	}
+}
+
+func newSideCooling(tWall, tAir []float64) SideCooling {
+	var result SideCooling
+	for i, t := range tWall {
+		if i == 0 || t > result.TWallMax {
+			result.TWallMax = t
+		}
+	}
+	if len(tAir) > 0 {
+		result.TAirOutlet = tAir[len(tAir)-1]
+	}
+	return result
+}
@@ -0,0 +1,382 @@
+package templ
+
+import (
+	"fmt"
+	"reflect"
+	"text/template"
+	"text/template/parse"
+)
+
+// CheckError - ошибка в шаблоне с указанием места в виде file:line:col
+type CheckError struct {
+	Location string
+	Message  string
+}
+
+func (e CheckError) Error() string {
+	return e.Location + ": " + e.Message
+}
+
+// noData - тип данных шаблонов, которые заполняются без данных (Insert(nil))
+type noData struct{}
+
+var (
+	noDataType = reflect.TypeOf(noData{})
+	errorType  = reflect.TypeOf((*error)(nil)).Elem()
+	stringType = reflect.TypeOf("")
+	boolType   = reflect.TypeOf(true)
+	intType    = reflect.TypeOf(0)
+)
+
+// Check статически проверяет обращения к полям и методам в шаблоне t, исполняемом над данными типа dataType.
+// Если dataType равен nil, шаблон не должен обращаться к данным. Типы, которые нельзя вывести статически
+// (поля-интерфейсы, результаты встроенных функций), не проверяются.
+func Check(t *template.Template, dataType reflect.Type, funcMap template.FuncMap) []CheckError {
+	if dataType == nil {
+		dataType = noDataType
+	}
+	var c = &checker{
+		template: t,
+		funcMap:  funcMap,
+		visited:  make(map[string]bool),
+	}
+	c.checkTree(t.Tree, dataType)
+	return c.errors
+}
+
+type checker struct {
+	template *template.Template
+	funcMap  template.FuncMap
+	visited  map[string]bool // шаблоны, уже проверенные с данным типом
+	errors   []CheckError
+
+	tree *parse.Tree
+	vars []map[string]reflect.Type
+}
+
+func (c *checker) checkTree(tree *parse.Tree, dot reflect.Type) {
+	if tree == nil || tree.Root == nil {
+		return
+	}
+	var tree0, vars0 = c.tree, c.vars
+	c.tree = tree
+	c.vars = []map[string]reflect.Type{{"$": dot}}
+	c.walk(tree.Root, dot)
+	c.tree, c.vars = tree0, vars0
+}
+
+func (c *checker) walk(node parse.Node, dot reflect.Type) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, item := range n.Nodes {
+			c.walk(item, dot)
+		}
+	case *parse.ActionNode:
+		c.pipe(n.Pipe, dot)
+	case *parse.IfNode:
+		c.pushScope()
+		c.pipe(n.Pipe, dot)
+		c.walk(n.List, dot)
+		c.walk(n.ElseList, dot)
+		c.popScope()
+	case *parse.WithNode:
+		c.pushScope()
+		var inner = c.pipe(n.Pipe, dot)
+		c.walk(n.List, inner)
+		c.walk(n.ElseList, dot)
+		c.popScope()
+	case *parse.RangeNode:
+		c.pushScope()
+		var key, elem = c.rangeTypes(n, c.pipeCommands(n.Pipe, dot))
+		switch len(n.Pipe.Decl) {
+		case 1:
+			c.declare(n.Pipe.Decl[0], elem)
+		case 2:
+			c.declare(n.Pipe.Decl[0], key)
+			c.declare(n.Pipe.Decl[1], elem)
+		}
+		c.walk(n.List, elem)
+		c.walk(n.ElseList, dot)
+		c.popScope()
+	case *parse.TemplateNode:
+		var data = noDataType
+		if n.Pipe != nil {
+			data = c.pipe(n.Pipe, dot)
+		}
+		c.checkNamed(n.Name, data)
+	}
+}
+
+func (c *checker) checkNamed(name string, dot reflect.Type) {
+	var key = fmt.Sprintf("%s/%v", name, dot)
+	if c.visited[key] {
+		return
+	}
+	c.visited[key] = true
+	if t := c.template.Lookup(name); t != nil {
+		c.checkTree(t.Tree, dot)
+	}
+}
+
+func (c *checker) rangeTypes(n *parse.RangeNode, t reflect.Type) (key, elem reflect.Type) {
+	if t == nil {
+		return nil, nil
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return intType, t.Elem()
+	case reflect.Map:
+		return t.Key(), t.Elem()
+	case reflect.Chan:
+		return t.Elem(), t.Elem()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return t, t
+	case reflect.Interface:
+		return nil, nil
+	}
+	c.errorf(n, "range can't iterate over %s", t)
+	return nil, nil
+}
+
+// pipe возвращает тип результата конвейера и объявляет его переменные
+func (c *checker) pipe(p *parse.PipeNode, dot reflect.Type) reflect.Type {
+	var result = c.pipeCommands(p, dot)
+	for _, v := range p.Decl {
+		if p.IsAssign {
+			continue
+		}
+		c.declare(v, result)
+	}
+	return result
+}
+
+func (c *checker) pipeCommands(p *parse.PipeNode, dot reflect.Type) reflect.Type {
+	if p == nil {
+		return nil
+	}
+	var result reflect.Type
+	for i, cmd := range p.Cmds {
+		var prev reflect.Type
+		if i > 0 {
+			prev = result
+		}
+		result = c.command(cmd, dot, prev, i > 0)
+	}
+	return result
+}
+
+// command возвращает тип результата команды; piped означает, что prev передается последним аргументом
+func (c *checker) command(cmd *parse.CommandNode, dot, prev reflect.Type, piped bool) reflect.Type {
+	var argTypes = make([]reflect.Type, 0, len(cmd.Args))
+	for _, arg := range cmd.Args[1:] {
+		argTypes = append(argTypes, c.arg(arg, dot))
+	}
+	if piped {
+		argTypes = append(argTypes, prev)
+	}
+
+	if ident, ok := cmd.Args[0].(*parse.IdentifierNode); ok {
+		return c.function(ident, argTypes)
+	}
+	return c.arg(cmd.Args[0], dot)
+}
+
+func (c *checker) arg(node parse.Node, dot reflect.Type) reflect.Type {
+	switch n := node.(type) {
+	case *parse.DotNode:
+		return dot
+	case *parse.FieldNode:
+		return c.fieldChain(n, dot, n.Ident)
+	case *parse.VariableNode:
+		var t, ok = c.lookup(n.Ident[0])
+		if !ok {
+			c.errorf(n, "undefined variable %s", n.Ident[0])
+			return nil
+		}
+		return c.fieldChain(n, t, n.Ident[1:])
+	case *parse.ChainNode:
+		return c.fieldChain(n, c.arg(n.Node, dot), n.Field)
+	case *parse.PipeNode:
+		c.pushScope()
+		defer c.popScope()
+		return c.pipe(n, dot)
+	case *parse.IdentifierNode:
+		return c.function(n, nil)
+	case *parse.StringNode:
+		return stringType
+	case *parse.BoolNode:
+		return boolType
+	}
+	return nil
+}
+
+func (c *checker) function(n *parse.IdentifierNode, argTypes []reflect.Type) reflect.Type {
+	if f, ok := c.funcMap[n.Ident]; ok {
+		var ft = reflect.TypeOf(f)
+		c.checkArgs(n, ft, argTypes)
+		if ft.NumOut() == 0 {
+			return nil
+		}
+		return ft.Out(0)
+	}
+	switch n.Ident {
+	case "print", "printf", "println", "html", "js", "urlquery":
+		return stringType
+	case "not", "eq", "ne", "lt", "le", "gt", "ge":
+		return boolType
+	case "len":
+		return intType
+	case "index":
+		var t reflect.Type
+		if len(argTypes) > 0 {
+			t = argTypes[0]
+		}
+		for i := 1; i < len(argTypes) && t != nil; i++ {
+			switch t.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				t = t.Elem()
+			default:
+				t = nil
+			}
+		}
+		return t
+	}
+	return nil
+}
+
+func (c *checker) checkArgs(n *parse.IdentifierNode, ft reflect.Type, argTypes []reflect.Type) {
+	var fixed = ft.NumIn()
+	if ft.IsVariadic() {
+		fixed--
+	}
+	if len(argTypes) < fixed || !ft.IsVariadic() && len(argTypes) > fixed {
+		c.errorf(n, "wrong number of args for %s: want %d got %d", n.Ident, fixed, len(argTypes))
+		return
+	}
+	for i, t := range argTypes {
+		var want reflect.Type
+		if i < fixed {
+			want = ft.In(i)
+		} else {
+			want = ft.In(fixed).Elem()
+		}
+		if t == nil || t.Kind() == reflect.Interface {
+			continue
+		}
+
+		var ok bool
+		switch {
+		case want == numberType:
+			ok = isNumber(t)
+		case want.Kind() == reflect.Interface:
+			ok = t.Implements(want)
+		default:
+			ok = t.AssignableTo(want)
+		}
+		if !ok {
+			c.errorf(n, "wrong type for value; expected %s; got %s", typeName(want), t)
+		}
+	}
+}
+
+func typeName(t reflect.Type) string {
+	if t == numberType {
+		return "number"
+	}
+	return t.String()
+}
+
+// fieldChain последовательно разрешает поля и методы names начиная с типа t
+func (c *checker) fieldChain(node parse.Node, t reflect.Type, names []string) reflect.Type {
+	for _, name := range names {
+		if t == nil {
+			return nil
+		}
+		var next, err = fieldType(t, name)
+		if err != nil {
+			c.errorf(node, "%v", err)
+			return nil
+		}
+		t = next
+	}
+	return t
+}
+
+// fieldType возвращает тип поля или результата метода name типа t; nil означает, что тип неизвестен
+func fieldType(t reflect.Type, name string) (reflect.Type, error) {
+	if t == noDataType {
+		return nil, fmt.Errorf("template receives no data, can't evaluate field %s", name)
+	}
+	for {
+		if m, ok := methodByName(t, name); ok {
+			var mt = m.Type
+			if mt.NumOut() == 0 || mt.NumOut() > 2 || mt.NumOut() == 2 && mt.Out(1) != errorType {
+				return nil, fmt.Errorf("method %s.%s can't be used in template", t, name)
+			}
+			return mt.Out(0), nil
+		}
+		if t.Kind() != reflect.Ptr {
+			break
+		}
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if f, ok := t.FieldByName(name); ok {
+			if f.PkgPath != "" {
+				return nil, fmt.Errorf("%s is an unexported field of struct type %s", name, t)
+			}
+			return f.Type, nil
+		}
+	case reflect.Map:
+		if t.Key().Kind() == reflect.String {
+			return t.Elem(), nil
+		}
+	case reflect.Interface:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("can't evaluate field %s in type %s", name, t)
+}
+
+func methodByName(t reflect.Type, name string) (reflect.Method, bool) {
+	if m, ok := t.MethodByName(name); ok {
+		return m, true
+	}
+	if t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface {
+		return reflect.PtrTo(t).MethodByName(name)
+	}
+	return reflect.Method{}, false
+}
+
+func (c *checker) declare(v *parse.VariableNode, t reflect.Type) {
+	c.vars[len(c.vars)-1][v.Ident[0]] = t
+}
+
+func (c *checker) lookup(name string) (reflect.Type, bool) {
+	for i := len(c.vars) - 1; i >= 0; i-- {
+		if t, ok := c.vars[i][name]; ok {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func (c *checker) pushScope() {
+	c.vars = append(c.vars, make(map[string]reflect.Type))
+}
+
+func (c *checker) popScope() {
+	c.vars = c.vars[:len(c.vars)-1]
+}
+
+func (c *checker) errorf(node parse.Node, format string, args ...interface{}) {
+	var location, _ = c.tree.ErrorContext(node)
+	c.errors = append(c.errors, CheckError{Location: location, Message: fmt.Sprintf(format, args...)})
+}
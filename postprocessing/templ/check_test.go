@@ -0,0 +1,135 @@
+package templ
+
+import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+type testTriangle interface {
+	C() float64
+}
+
+type testRow struct {
+	Id    int
+	Value float64
+}
+
+type testDF struct {
+	Name     string
+	P1       float64
+	TIn      units.Kelvin
+	Triangle testTriangle
+	Rows     []testRow
+	Params   map[string]float64
+	hidden   float64
+}
+
+func (df testDF) TableRows() chan testRow {
+	return nil
+}
+
+func (df *testDF) Total() (float64, error) {
+	return 0, nil
+}
+
+func checkTemplate(t *testing.T, content string, sample interface{}) []CheckError {
+	var tmpl, err = GetTemplate("test.tex", content, GetFuncMap())
+	require.NoError(t, err)
+	return Check(tmpl, reflect.TypeOf(sample), GetFuncMap())
+}
+
+func TestCheck_Valid(t *testing.T) {
+	var errs = checkTemplate(t, `<-<.Name>-> <-<.P1 | DivideE6 | Round3>-> <-<.Triangle.C | Round1>->
+<-<range .Rows>-><-<.Id>-> & <-<.Value | Round2>-><-<end>->
+<-<range $i, $row := .TableRows>-><-<$i>-> <-<$row.Value>-> <-<$.Name>-><-<end>->
+<-<with .Triangle>-><-<.C>-><-<end>-> <-<.Params.pi>-> <-<.Total | Round>->
+<-<if .Rows>-><-<(index .Rows 0).Value>-><-<end>->
+<-<Q .TIn "°C">-> <-<Q .TIn "K" 3>-> <-<.TIn | Round1>->`, testDF{})
+	assert.Empty(t, errs)
+}
+
+func TestCheck_Errors(t *testing.T) {
+	var errs = checkTemplate(t, `<-<.Name>->
+<-<.P2 | Round1>->
+<-<range .Rows>-><-<.Id>-> <-<.Valeu>-><-<end>->
+<-<.hidden>-> <-<.Name | Round1>->
+<-<Q .P1 "MPa">->`, &testDF{})
+	require.Len(t, errs, 5)
+	assert.Equal(t, "test.tex:2:3", errs[0].Location)
+	assert.Contains(t, errs[0].Message, "can't evaluate field P2")
+	assert.Equal(t, "test.tex:3:30", errs[1].Location)
+	assert.Contains(t, errs[1].Message, "can't evaluate field Valeu in type templ.testRow")
+	assert.Contains(t, errs[2].Message, "unexported field")
+	assert.Contains(t, errs[3].Message, "expected number; got string")
+	assert.Equal(t, "test.tex:4:25: "+errs[3].Message, errs[3].Error())
+	assert.Contains(t, errs[4].Message, "expected units.Quantity; got float64")
+}
+
+func TestCheck_NoData(t *testing.T) {
+	assert.Empty(t, checkTemplate(t, `\section{Введение}`, nil))
+
+	var errs = checkTemplate(t, `<-<.Title>->`, nil)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "template receives no data")
+}
+
+func TestRegistry_Check(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "templ")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for name, content := range map[string]string{
+		"valid.tex":        `<-<.P1 | Round1>->`,
+		"broken.tex":       `<-<.P2>->`,
+		"static.tex":       `\chapter{Введение}`,
+		"unregistered.tex": `<-<.Name>->`,
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	var errs = NewRegistry(
+		dir,
+		Register("valid.tex", testDF{}),
+		Register("broken.tex", testDF{}),
+		Register("missing.tex", testDF{}),
+	).Check()
+	require.Len(t, errs, 3)
+	assert.Contains(t, errs[0].Error(), "broken.tex:1:3: can't evaluate field P2")
+	assert.Equal(t, filepath.Join(dir, "missing.tex")+": template is missing", errs[1].Error())
+	assert.Equal(t, filepath.Join(dir, "unregistered.tex")+": template is not registered", errs[2].Error())
+}
+
+func TestRegistry_Overlay(t *testing.T) {
+	var dir, err = ioutil.TempDir("", "templ")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var overlayDir = filepath.Join(dir, "en")
+	require.NoError(t, os.Mkdir(overlayDir, 0755))
+	for name, content := range map[string]string{
+		"valid.tex":    `<-<.P1 | Round1>->`,
+		"other.tex":    `<-<.Name>->`,
+		"en/valid.tex": `<-<.P2>->`,
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	var registry = NewRegistry(
+		dir,
+		Register("valid.tex", testDF{}),
+		Register("other.tex", testDF{}),
+	)
+	assert.Empty(t, registry.Check())
+
+	// other.tex не переведен, и это ошибка перевода
+	var errs = registry.Overlay(overlayDir).Check()
+	require.Len(t, errs, 2)
+	assert.Contains(t, errs[0].Error(), filepath.Join("en", "valid.tex")+":1:3: can't evaluate field P2")
+	assert.Equal(t, filepath.Join(overlayDir, "other.tex")+": template is missing", errs[1].Error())
+}
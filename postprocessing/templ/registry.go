@@ -0,0 +1,105 @@
+package templ
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Entry связывает файл шаблона с типом данных, которыми он заполняется
+type Entry struct {
+	File string
+	Type reflect.Type // nil - шаблон заполняется без данных
+}
+
+// Register возвращает запись для шаблона file, заполняемого значениями того же типа, что sample.
+// sample, равный nil, означает шаблон без данных.
+func Register(file string, sample interface{}) Entry {
+	return Entry{File: file, Type: reflect.TypeOf(sample)}
+}
+
+type Registry struct {
+	dir     string
+	entries []Entry
+}
+
+func NewRegistry(dir string, entries ...Entry) Registry {
+	return Registry{dir: dir, entries: entries}
+}
+
+// Overlay возвращает реестр каталога dir с переводом шаблонов на другой язык.
+// Перевод должен содержать все зарегистрированные шаблоны, отсутствующий шаблон считается ошибкой.
+func (r Registry) Overlay(dir string) Registry {
+	return Registry{dir: dir, entries: r.entries}
+}
+
+// Check разбирает все зарегистрированные шаблоны и проверяет обращения к полям по типам данных.
+// Шаблоны каталога с подстановками, отсутствующие в реестре, также считаются ошибкой.
+func (r Registry) Check() []error {
+	var result []error
+	var registered = make(map[string]bool)
+	for _, entry := range r.entries {
+		registered[entry.File] = true
+		result = append(result, r.checkEntry(entry)...)
+	}
+
+	var unregistered, err = r.unregistered(registered)
+	if err != nil {
+		return append(result, err)
+	}
+	for _, file := range unregistered {
+		result = append(result, CheckError{
+			Location: filepath.Join(r.dir, file),
+			Message:  "template is not registered",
+		})
+	}
+	return result
+}
+
+func (r Registry) checkEntry(entry Entry) []error {
+	var path = filepath.Join(r.dir, entry.File)
+	var content, err = ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return []error{CheckError{Location: path, Message: "template is missing"}}
+	}
+	if err != nil {
+		return []error{err}
+	}
+	var funcMap = GetFuncMap()
+	t, err := GetTemplate(path, string(content), funcMap)
+	if err != nil {
+		return []error{err}
+	}
+
+	var result []error
+	for _, checkErr := range Check(t, entry.Type, funcMap) {
+		result = append(result, checkErr)
+	}
+	return result
+}
+
+// unregistered возвращает имена файлов каталога с подстановками, которых нет в реестре
+func (r Registry) unregistered(registered map[string]bool) ([]string, error) {
+	var infos, err = ioutil.ReadDir(r.dir)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, info := range infos {
+		if info.IsDir() || registered[info.Name()] {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(r.dir, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if strings.Contains(string(content), leftDelim) {
+			result = append(result, info.Name())
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
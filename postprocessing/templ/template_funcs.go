@@ -1,34 +1,118 @@
 package templ
 
 import (
+	"fmt"
 	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
 	"github.com/Sovianum/turbocycle/common"
 	"math"
+	"reflect"
 	"text/template"
 )
 
+const (
+	leftDelim  = "<-<"
+	rightDelim = ">->"
+)
+
+// Number - аргумент числовых функций шаблонов: float64 или величина с единицами из пакета units
+type Number interface{}
+
+var numberType = reflect.TypeOf((*Number)(nil)).Elem()
+
 func GetTemplate(name, content string, funcMap template.FuncMap) (*template.Template, error) {
 	return template.
 		New(name).
-		Delims("<-<", ">->").
+		Delims(leftDelim, rightDelim).
 		Funcs(funcMap).
 		Parse(content)
 }
 
 func GetFuncMap() template.FuncMap {
 	return template.FuncMap{
-		"Round":      dataframes.Round,
-		"Round1":     dataframes.Round1,
-		"Round2":     dataframes.Round2,
-		"Round3":     dataframes.Round3,
-		"DivideE3":   dataframes.DivideE3,
-		"MultiplyE3": dataframes.MultiplyE3,
-		"DivideE5":   dataframes.DivideE5,
-		"MultiplyE5": dataframes.MultiplyE5,
-		"DivideE6":   dataframes.DivideE6,
-		"MultiplyE6": dataframes.MultiplyE6,
-		"Abs":        math.Abs,
-		"Degree":     common.ToDegrees,
-		"Radian":     common.ToRadians,
+		"Round":      stringFunc(dataframes.Round),
+		"Round1":     stringFunc(dataframes.Round1),
+		"Round2":     stringFunc(dataframes.Round2),
+		"Round3":     stringFunc(dataframes.Round3),
+		"DivideE3":   floatFunc(dataframes.DivideE3),
+		"MultiplyE3": floatFunc(dataframes.MultiplyE3),
+		"DivideE5":   floatFunc(dataframes.DivideE5),
+		"MultiplyE5": floatFunc(dataframes.MultiplyE5),
+		"DivideE6":   floatFunc(dataframes.DivideE6),
+		"MultiplyE6": floatFunc(dataframes.MultiplyE6),
+		"Abs":        abs,
+		"Degree":     floatFunc(common.ToDegrees),
+		"Radian":     floatFunc(common.ToRadians),
+		"Q":          Q,
+	}
+}
+
+// Q переводит величину в единицы symbol и выводит ее с units.DefaultDigits значащими цифрами
+// либо с числом цифр, указанным третьим аргументом
+func Q(q units.Quantity, symbol string, digits ...int) (string, error) {
+	var n = units.DefaultDigits
+	switch len(digits) {
+	case 0:
+	case 1:
+		n = digits[0]
+	default:
+		return "", fmt.Errorf("Q expects at most one digits argument, got %d", len(digits))
+	}
+	return units.Format(q, symbol, n)
+}
+
+// abs возвращает модуль числа, сохраняя его тип, чтобы результат можно было передать в Q
+func abs(value Number) (Number, error) {
+	var x, err = toFloat(value)
+	if err != nil {
+		return nil, err
+	}
+	var v = reflect.New(reflect.TypeOf(value)).Elem()
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(math.Abs(x))
+	default:
+		v.SetInt(int64(math.Abs(x)))
+	}
+	return v.Interface(), nil
+}
+
+func floatFunc(f func(float64) float64) func(Number) (float64, error) {
+	return func(value Number) (float64, error) {
+		var x, err = toFloat(value)
+		if err != nil {
+			return 0, err
+		}
+		return f(x), nil
+	}
+}
+
+func stringFunc(f func(float64) string) func(Number) (string, error) {
+	return func(value Number) (string, error) {
+		var x, err = toFloat(value)
+		if err != nil {
+			return "", err
+		}
+		return f(x), nil
+	}
+}
+
+// toFloat приводит числа, в том числе типы величин пакета units, к float64
+func toFloat(value Number) (float64, error) {
+	var v = reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	}
+	return 0, fmt.Errorf("expected number, got %T", value)
+}
+
+func isNumber(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
 	}
+	return false
 }
@@ -0,0 +1,51 @@
+package templ
+
+import (
+	"bytes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/units"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func execute(t *testing.T, content string, data interface{}) (string, error) {
+	var tmpl, err = GetTemplate("test", content, GetFuncMap())
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+	return buf.String(), err
+}
+
+func TestAbs(t *testing.T) {
+	var data = struct {
+		Gamma units.Radian
+		X     float64
+	}{Gamma: -math.Pi / 6, X: -1.25}
+
+	var s, err = execute(t, `<-<Q (Abs .Gamma) "°">->; <-<.X | Abs | Round2>->`, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "30,00; 1,25", s)
+}
+
+func TestQ(t *testing.T) {
+	var data = struct {
+		TIn  units.Kelvin
+		POut units.Pascal
+		P    float64
+	}{TIn: 288.16, POut: 1.5e6, P: 1.5e6}
+
+	var s, err = execute(t, `<-<Q .TIn "K">->; <-<Q .TIn "°C" 2>->; <-<Q .POut "MPa">->; <-<.POut | DivideE6 | Round2>->`, data)
+	assert.NoError(t, err)
+	assert.Equal(t, "288,2; 15; 1,500; 1,50", s)
+
+	_, err = execute(t, `<-<Q .TIn "MPa">->`, data)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can't convert temperature to MPa")
+
+	_, err = execute(t, `<-<Q .P "MPa">->`, data)
+	assert.Error(t, err)
+
+	_, err = execute(t, `<-<Q .TIn "K" 3 4>->`, data)
+	assert.Error(t, err)
+}
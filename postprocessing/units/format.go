@@ -0,0 +1,31 @@
+package units
+
+import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"math"
+	"strconv"
+)
+
+// DefaultDigits - число значащих цифр, с которым выводятся величины
+const DefaultDigits = 4
+
+// Format переводит q в единицы symbol и округляет до digits значащих цифр.
+// Целая часть не округляется, десятичный разделитель определяется текущим языком.
+func Format(q Quantity, symbol string, digits int) (string, error) {
+	var value, err = Convert(q, symbol)
+	if err != nil {
+		return "", err
+	}
+	return FormatSignificant(value, digits), nil
+}
+
+func FormatSignificant(value float64, digits int) string {
+	var decimals = 0
+	if value != 0 && !math.IsInf(value, 0) && !math.IsNaN(value) {
+		decimals = digits - 1 - int(math.Floor(math.Log10(math.Abs(value))))
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+	return locale.Decimal(strconv.FormatFloat(value, 'f', decimals, 64))
+}
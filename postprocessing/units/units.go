@@ -0,0 +1,109 @@
+package units
+
+import (
+	"fmt"
+	"math"
+)
+
+type Dimension int
+
+const (
+	Temperature Dimension = iota
+	Pressure
+	MassRate
+	SpecificEnergy
+	Angle
+)
+
+var dimensionNames = map[Dimension]string{
+	Temperature:    "temperature",
+	Pressure:       "pressure",
+	MassRate:       "mass rate",
+	SpecificEnergy: "specific energy",
+	Angle:          "angle",
+}
+
+func (d Dimension) String() string {
+	return dimensionNames[d]
+}
+
+// Unit переводит значение из единиц СИ: value = si / Scale - Offset
+type Unit struct {
+	Symbol    string
+	Dimension Dimension
+	Scale     float64
+	Offset    float64
+}
+
+func (u Unit) FromSI(si float64) float64 {
+	return si/u.Scale - u.Offset
+}
+
+var unitList = []Unit{
+	{Symbol: "K", Dimension: Temperature, Scale: 1},
+	{Symbol: "°C", Dimension: Temperature, Scale: 1, Offset: 273.15},
+	{Symbol: "Pa", Dimension: Pressure, Scale: 1},
+	{Symbol: "kPa", Dimension: Pressure, Scale: 1e3},
+	{Symbol: "MPa", Dimension: Pressure, Scale: 1e6},
+	{Symbol: "bar", Dimension: Pressure, Scale: 1e5},
+	{Symbol: "kg/s", Dimension: MassRate, Scale: 1},
+	{Symbol: "J/kg", Dimension: SpecificEnergy, Scale: 1},
+	{Symbol: "kJ/kg", Dimension: SpecificEnergy, Scale: 1e3},
+	{Symbol: "MJ/kg", Dimension: SpecificEnergy, Scale: 1e6},
+	{Symbol: "rad", Dimension: Angle, Scale: 1},
+	{Symbol: "°", Dimension: Angle, Scale: math.Pi / 180},
+	{Symbol: "deg", Dimension: Angle, Scale: math.Pi / 180},
+}
+
+var unitsBySymbol = func() map[string]Unit {
+	var result = make(map[string]Unit, len(unitList))
+	for _, u := range unitList {
+		result[u.Symbol] = u
+	}
+	return result
+}()
+
+func GetUnit(symbol string) (Unit, error) {
+	if u, ok := unitsBySymbol[symbol]; ok {
+		return u, nil
+	}
+	return Unit{}, fmt.Errorf("unknown unit %q", symbol)
+}
+
+// Quantity - значение в единицах СИ с известной размерностью
+type Quantity interface {
+	SI() float64
+	Dimension() Dimension
+}
+
+// Convert переводит q в единицы symbol
+func Convert(q Quantity, symbol string) (float64, error) {
+	var u, err = GetUnit(symbol)
+	if err != nil {
+		return 0, err
+	}
+	if u.Dimension != q.Dimension() {
+		return 0, fmt.Errorf("can't convert %s to %s (%s)", q.Dimension(), symbol, u.Dimension)
+	}
+	return u.FromSI(q.SI()), nil
+}
+
+// Типы полей датафреймов; значения хранятся в единицах СИ
+type (
+	Kelvin      float64
+	Pascal      float64
+	KgPerSecond float64
+	JPerKg      float64
+	Radian      float64
+)
+
+func (v Kelvin) SI() float64               { return float64(v) }
+func (v Kelvin) Dimension() Dimension      { return Temperature }
+func (v Pascal) SI() float64               { return float64(v) }
+func (v Pascal) Dimension() Dimension      { return Pressure }
+func (v KgPerSecond) SI() float64          { return float64(v) }
+func (v KgPerSecond) Dimension() Dimension { return MassRate }
+func (v JPerKg) SI() float64               { return float64(v) }
+func (v JPerKg) Dimension() Dimension      { return SpecificEnergy }
+func (v Radian) SI() float64               { return float64(v) }
+func (v Radian) Dimension() Dimension      { return Angle }
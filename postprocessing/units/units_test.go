@@ -0,0 +1,68 @@
+package units
+
+import (
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	var testCases = []struct {
+		q        Quantity
+		symbol   string
+		expected float64
+	}{
+		{Kelvin(288.15), "K", 288.15},
+		{Kelvin(288.15), "°C", 15},
+		{Pascal(1.5e6), "MPa", 1.5},
+		{Pascal(1.5e5), "bar", 1.5},
+		{Pascal(101325), "kPa", 101.325},
+		{KgPerSecond(45), "kg/s", 45},
+		{JPerKg(350e3), "kJ/kg", 350},
+		{Radian(math.Pi / 6), "°", 30},
+		{Radian(math.Pi / 6), "deg", 30},
+	}
+	for _, tc := range testCases {
+		var value, err = Convert(tc.q, tc.symbol)
+		assert.NoError(t, err, tc.symbol)
+		assert.InDelta(t, tc.expected, value, 1e-9, tc.symbol)
+	}
+}
+
+func TestConvert_Errors(t *testing.T) {
+	var _, err = Convert(Kelvin(300), "MPa")
+	assert.Error(t, err)
+	assert.Equal(t, "can't convert temperature to MPa (pressure)", err.Error())
+
+	_, err = Convert(Pascal(1e5), "atm")
+	assert.Error(t, err)
+	assert.Equal(t, `unknown unit "atm"`, err.Error())
+}
+
+func TestFormatSignificant(t *testing.T) {
+	assert.Equal(t, "1450", FormatSignificant(1450.3, 4))
+	assert.Equal(t, "288,2", FormatSignificant(288.16, 4))
+	assert.Equal(t, "0,1013", FormatSignificant(0.101325, 4))
+	assert.Equal(t, "12346", FormatSignificant(12345.6, 4))
+	assert.Equal(t, "-0,0125", FormatSignificant(-0.0125, 3))
+	assert.Equal(t, "0", FormatSignificant(0, 4))
+	assert.Equal(t, "0,000001", FormatSignificant(1e-6, 1))
+}
+
+func TestFormatSignificant_English(t *testing.T) {
+	defer locale.Set(locale.Current())
+	locale.Set(locale.English)
+
+	assert.Equal(t, "288.2", FormatSignificant(288.16, 4))
+	assert.Equal(t, "1450", FormatSignificant(1450.3, 4))
+}
+
+func TestFormat(t *testing.T) {
+	var s, err = Format(Kelvin(1473.15), "°C", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, "1200", s)
+
+	_, err = Format(JPerKg(1), "K", 4)
+	assert.Error(t, err)
+}
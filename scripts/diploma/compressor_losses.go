@@ -0,0 +1,84 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/losses"
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+)
+
+// saveCompressorLossComparison сравнивает КПД ступеней компрессоров, подобранных по циклу, с моделью потерь
+func saveCompressorLossComparison(fitted *midall.StagedScheme3n) {
+	var lossConfig = getCompressorLossModelConfig()
+	var dfs []dataframes.CompressorLossDF
+	for _, item := range []struct {
+		name  string
+		title string
+		node  compressor.StagedCompressorNode
+	}{
+		{"lpc", locale.T("КНД"), fitted.LPC},
+		{"hpc", locale.T("КВД"), fitted.HPC},
+	} {
+		var predictions, err = midall.PredictCompressorLosses(item.node, lossConfig)
+		if err != nil {
+			panic(err)
+		}
+
+		var matrix = make([][]float64, len(predictions))
+		for i, p := range predictions {
+			fmt.Printf(
+				"%s stage %d: eta %.3f / %.3f, D %.3f / %.3f, dH %.3f / %.3f\n",
+				item.name, i+1, p.EtaFitted, p.EtaPredicted,
+				p.RotorCheck.DiffusionFactor, p.StatorCheck.DiffusionFactor,
+				p.RotorCheck.DeHaller, p.StatorCheck.DeHaller,
+			)
+			if !p.Passed() {
+				fmt.Printf("%s stage %d: diffusion limits exceeded\n", item.name, i+1)
+			}
+
+			var passed = 0.
+			if p.Passed() {
+				passed = 1
+			}
+			matrix[i] = []float64{
+				float64(i + 1),
+				p.EtaFitted, p.EtaPredicted,
+				p.RotorCheck.DiffusionFactor, p.RotorCheck.DeHaller,
+				p.StatorCheck.DiffusionFactor, p.StatorCheck.DeHaller,
+				p.RotorLoss.Profile, p.RotorLoss.EndWall, p.RotorLoss.TipClearance,
+				p.StatorLoss.Profile, p.StatorLoss.EndWall,
+				passed,
+			}
+		}
+		if err := profiling.SaveMatrix(dataDir+"/"+item.name+"_"+compressorLossData, matrix); err != nil {
+			panic(err)
+		}
+		dfs = append(dfs, dataframes.NewCompressorLossDF(
+			item.name, item.title, useLossModel, lossConfig.Limits, predictions,
+		))
+	}
+
+	var inserter = templ.NewDataInserter(
+		templatePath(compressorLossTemplate),
+		buildDir+"/"+compressorLossOut,
+	)
+	if err := inserter.Insert(dfs); err != nil {
+		panic(err)
+	}
+}
+
+func getCompressorLossModelConfig() midall.CompressorLossModelConfig {
+	return midall.CompressorLossModelConfig{
+		RotorSolidity:  compressorSolidity,
+		StatorSolidity: compressorSolidity,
+		RotorTipGapRel: compressorTipGapRel,
+		Limits: losses.DiffusionLimits{
+			MaxDiffusionFactor: maxDiffusionFactor,
+			MinDeHaller:        minDeHaller,
+		},
+	}
+}
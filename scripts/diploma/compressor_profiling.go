@@ -0,0 +1,97 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/drawing"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/stage/compressor"
+)
+
+var compressorSectionNames = []string{"root", "mid", "top"}
+
+func saveCompressorProfiles(machines *midall.StagedScheme3n) {
+	var inserter = templ.NewDataInserter(
+		templatePath(profileQualityTemplate),
+		buildDir+"/"+compressorProfileQualityOut,
+	)
+
+	var dfs []dataframes.ProfileQualityDF
+	dfs = append(dfs, saveCompressorMachineProfiles(machines.LPC, "lpc", locale.T("КНД"))...)
+	dfs = append(dfs, saveCompressorMachineProfiles(machines.HPC, "hpc", locale.T("КВД"))...)
+	if err := inserter.Insert(dfs); err != nil {
+		panic(err)
+	}
+}
+
+func saveCompressorMachineProfiles(
+	machine compressor.StagedCompressorNode, name, title string,
+) []dataframes.ProfileQualityDF {
+	var result []dataframes.ProfileQualityDF
+	for i, stage := range machine.Stages() {
+		for _, isRotor := range []bool{true, false} {
+			var rowName, rowTitle = fmt.Sprintf("%s_%d_rotor", name, i+1), locale.Tf("%s, ступень %d, РК", title, i+1)
+			if !isRotor {
+				rowName, rowTitle = fmt.Sprintf("%s_%d_stator", name, i+1), locale.Tf("%s, ступень %d, НА", title, i+1)
+			}
+			result = append(result, saveCompressorRowProfiles(stage, isRotor, rowName, rowTitle))
+		}
+	}
+	return result
+}
+
+func saveCompressorRowProfiles(
+	stage compressor.StageNode, isRotor bool, name, title string,
+) dataframes.ProfileQualityDF {
+	var sections, err = profiling.NewCompressorRowSections(
+		stage,
+		profiling.CompressorBladeConfig{
+			Family:       compressorProfileFamily,
+			ThicknessRel: compressorThicknessRel,
+			Solidity:     compressorSolidity,
+			PointNum:     compressorProfilePointNum,
+		},
+		profiling.LawSpec{Name: compressorVortexLaw},
+		isRotor,
+		[]float64{0, 0.5, 1},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	var cascades = make([]profiling.CascadeSection, len(sections))
+	for i, section := range sections {
+		var contour = section.Contour()
+		var neighbour = make([][]float64, len(contour))
+		for j, p := range contour {
+			neighbour[j] = []float64{p[0], p[1] + section.Pitch}
+		}
+
+		var dataName = name + "_" + compressorSectionNames[i]
+		for j, coordinates := range [][][]float64{contour, neighbour} {
+			var path = fmt.Sprintf("%s/%s_%d.csv", dataDir, dataName, j+1)
+			if err := profiling.SaveMatrix(path, coordinates); err != nil {
+				panic(err)
+			}
+		}
+		saveDrawing(drawing.NewProfileDrawing(contour), dataName+"_blade")
+		saveDrawing(drawing.NewCascadeDrawing([][][]float64{contour, neighbour}), dataName+"_cascade")
+
+		cascades[i] = section.Cascade()
+	}
+
+	var limits = profiling.QualityLimits{
+		MaxIncidence:    common.ToRadians(compressorMaxIncidence),
+		MinThicknessRel: qualityMinThicknessRel,
+		MaxThicknessRel: compressorMaxThicknessRel,
+	}
+	quality, err := profiling.AnalyzeSections(cascades, limits)
+	if err != nil {
+		panic(err)
+	}
+	return dataframes.NewProfileQualityDF(name, title, limits, quality)
+}
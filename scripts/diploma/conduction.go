@@ -0,0 +1,69 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/conduction"
+	cooling2 "github.com/Sovianum/cooling-course-project/core/cooling"
+	states2 "github.com/Sovianum/turbocycle/impl/engine/states"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
+)
+
+func saveConductionField(
+	coolMassRate,
+	meanAlphaGas float64,
+	stage turbine.StageNode,
+	bladeProfile profiles.BladeProfile,
+	psSolution profile.TemperatureSolution,
+	ssSolution profile.TemperatureSolution,
+) {
+	var field = getConductionField(coolMassRate, meanAlphaGas, stage, bladeProfile, psSolution, ssSolution)
+	var tMin, tMax = field.MinMax()
+	fmt.Printf("conduction 2D: iter = %d\ttMin = %.2f\ttMax = %.2f\n", field.Iter, tMin, tMax)
+
+	if err := conduction.SaveCSV(dataDir+"/"+conductionCSVData, field); err != nil {
+		panic(err)
+	}
+	if err := conduction.SaveVTK(dataDir+"/"+conductionVTKData, field); err != nil {
+		panic(err)
+	}
+}
+
+func getConductionField(
+	coolMassRate,
+	meanAlphaGas float64,
+	stage turbine.StageNode,
+	bladeProfile profiles.BladeProfile,
+	psSolution profile.TemperatureSolution,
+	ssSolution profile.TemperatureSolution,
+) *conduction.Field {
+	var grid, err = conduction.NewProfileGrid(bladeProfile, cooling2.WallThk, conductionGridStep, conductionPointNum)
+	if err != nil {
+		panic(err)
+	}
+	var locator = conduction.NewProfileSideLocator(bladeProfile, conductionPointNum)
+
+	var psAlphaGas, psAlphaAir = getAlphaLaws(
+		coolMassRate, meanAlphaGas, stage, bladeProfile, cooling2.PSProfileGasAlphaLaw,
+	)
+	var ssAlphaGas, ssAlphaAir = getAlphaLaws(
+		coolMassRate, meanAlphaGas, stage, bladeProfile, cooling2.SSProfileGasAlphaLaw,
+	)
+	var tGas = stage.TemperatureInput().GetState().(states2.TemperaturePortState).TStag
+
+	var solver = conduction.NewSolver(
+		grid,
+		func(t float64) float64 {
+			return cooling2.LambdaM
+		},
+		conduction.NewGasBC(locator, psAlphaGas, ssAlphaGas, tGas),
+		conduction.NewCoolantBC(locator, psAlphaAir, ssAlphaAir, psSolution, ssSolution),
+		conductionRelaxCoef, conductionPrecision, conductionIterLimit,
+	)
+	field, err := solver.Solve(theta0)
+	if err != nil {
+		panic(err)
+	}
+	return field
+}
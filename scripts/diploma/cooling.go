@@ -3,6 +3,8 @@ package diploma
 import (
 	"fmt"
 	cooling2 "github.com/Sovianum/cooling-course-project/core/cooling"
+	"github.com/Sovianum/cooling-course-project/core/cooling/integration"
+	"github.com/Sovianum/cooling-course-project/core/cooling/nusselt"
 	"github.com/Sovianum/cooling-course-project/core/profiling"
 	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
 	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
@@ -26,7 +28,7 @@ import (
 
 func saveCooling2Template(df dataframes.TProfileCalcDF) {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+cooling2Template,
+		templatePath(cooling2Template),
 		buildDir+"/"+cooling2Out,
 	)
 
@@ -59,7 +61,7 @@ func optimizeCoolingSystem(
 ) (*mat.VecDense, error) {
 	optFunc := func(posVec *mat.VecDense) (float64, error) {
 		system := sysFunc(posVec)
-		solution := system.Solve(0, theta0, 1, 0.001)
+		solution := system.Solve(0, theta0, 1, odeStep)
 		resultID := common.MaxID(solution.SmoothWallTemperature)
 
 		fmt.Printf("x = %.5f\ty = %.2f\n", solution.LengthCoord[resultID], solution.SmoothWallTemperature[resultID])
@@ -129,7 +131,7 @@ func getSSConvTemperatureSystem(
 	var segment = profiles.SSSegment(profile, 0.5, 0.5)
 	var alphaGasFunc, alphaAirFunc = getAlphaLaws(coolMassRate, meanAlphaGas, stage, profile, cooling2.SSProfileGasAlphaLaw)
 	if system, err := cooling2.GetInitedStatorConvTemperatureSystem(
-		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc,
+		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc, getODEConfig(),
 	); err != nil {
 		panic(err)
 	} else {
@@ -146,7 +148,7 @@ func getPSConvTemperatureSystem(
 	var segment = profiles.PSSegment(profile, 0.5, 0.5)
 	var alphaGasFunc, alphaAirFunc = getAlphaLaws(coolMassRate, meanAlphaGas, stage, profile, cooling2.PSProfileGasAlphaLaw)
 	if system, err := cooling2.GetInitedStatorConvTemperatureSystem(
-		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc,
+		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc, getODEConfig(),
 	); err != nil {
 		panic(err)
 	} else {
@@ -165,6 +167,7 @@ func getSSConvFilmTemperatureSystem(
 	stage turbine.StageNode,
 	bladeProfile profiles.BladeProfile,
 	slitGeomData []SlitGeom,
+	odeConf integration.Config,
 ) profile.TemperatureSystem {
 	var segment = profiles.SSSegment(bladeProfile, 0.5, 0.5)
 	var alphaGasFunc, alphaAirFunc = getAlphaLaws(coolMassRate, meanAlphaGas, stage, bladeProfile, cooling2.SSProfileGasAlphaLaw)
@@ -182,7 +185,7 @@ func getSSConvFilmTemperatureSystem(
 	}
 
 	if system, err := cooling2.GetInitedStatorConvFilmTemperatureSystem(
-		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc, lambdaLaw, slitInfoArr,
+		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc, lambdaLaw, slitInfoArr, odeConf,
 	); err != nil {
 		panic(err)
 	} else {
@@ -196,6 +199,7 @@ func getPSConvFilmTemperatureSystem(
 	stage turbine.StageNode,
 	bladeProfile profiles.BladeProfile,
 	slitGeomData []SlitGeom,
+	odeConf integration.Config,
 ) profile.TemperatureSystem {
 	var segment = profiles.PSSegment(bladeProfile, 0.5, 0.5)
 	var alphaGasFunc, alphaAirFunc = getAlphaLaws(coolMassRate, meanAlphaGas, stage, bladeProfile, cooling2.PSProfileGasAlphaLaw)
@@ -213,7 +217,7 @@ func getPSConvFilmTemperatureSystem(
 	}
 
 	if system, err := cooling2.GetInitedStatorConvFilmTemperatureSystem(
-		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc, lambdaLaw, slitInfoArr,
+		coolMassRate, stage, segment, alphaAirFunc, alphaGasFunc, lambdaLaw, slitInfoArr, odeConf,
 	); err != nil {
 		panic(err)
 	} else {
@@ -274,7 +278,7 @@ func saveCooling1Template(
 	df dataframes.GapCalcDF,
 ) {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+cooling1Template,
+		templatePath(cooling1Template),
 		buildDir+"/"+cooling1Out,
 	)
 
@@ -290,6 +294,7 @@ func saveCooling1Template(
 func getGapDF(
 	massRateArr []float64,
 	calculator gap.GapCalculator,
+	correlation nusselt.Correlation,
 ) dataframes.GapCalcDF {
 	var dataPackArr = make([]gap.DataPack, len(massRateArr))
 
@@ -301,16 +306,32 @@ func getGapDF(
 		dataPackArr[i] = pack
 	}
 	var gapCalcDF = dataframes.GapCalcFromDataPacks(dataPackArr)
-	gapCalcDF.Gas.NuCoef = 0.079 // todo remove hardcode
+	gapCalcDF.Gas.SetNuCorrelation(correlation, cooling2.PrGas)
+	printNuWarning(correlation, gapCalcDF.Gas.ReGas)
 
 	return gapCalcDF
 }
 
+func printNuWarning(correlation nusselt.Correlation, re float64) {
+	if err := nusselt.Warning(correlation, re, cooling2.PrGas); err != nil {
+		fmt.Printf("warning: %s\n", err.Error())
+	}
+}
+
+func getGasNuCorrelation() nusselt.Correlation {
+	if result, err := nusselt.Get(gasNuCorrelation); err != nil {
+		panic(err)
+	} else {
+		return result
+	}
+}
+
 func getGapCalculator(
 	stage turbine.StageNode,
 	profile profiles.BladeProfile,
+	correlation nusselt.Correlation,
 ) gap.GapCalculator {
-	if result, err := cooling2.GetInitedStatorGapCalculator(stage, profile); err != nil {
+	if result, err := cooling2.GetInitedStatorGapCalculator(stage, profile, correlation); err != nil {
 		panic(err)
 	} else {
 		return result
@@ -67,7 +67,7 @@ func getDataPack() coolingTestDataPack {
 	stagePack := stage.GetDataPack()
 	statorMidProfile.Transform(geom.Scale(geometry.ChordProjection(stagePack.StageGeometry.StatorGeometry())))
 
-	gapCalculator := getGapCalculator(stage, statorMidProfile)
+	gapCalculator := getGapCalculator(stage, statorMidProfile, getGasNuCorrelation())
 	gapPack := gapCalculator.GetPack(coolAirMassRate)
 
 	return coolingTestDataPack{
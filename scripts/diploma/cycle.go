@@ -12,7 +12,7 @@ import (
 
 func saveCycleTemplate(scheme schemes.ThreeShaftsScheme) {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+cycleTemplate,
+		templatePath(cycleTemplate),
 		buildDir+"/"+cycleOut,
 	)
 	var df = dataframes.NewThreeShaftsDF(power, etaR, scheme)
@@ -21,6 +21,17 @@ func saveCycleTemplate(scheme schemes.ThreeShaftsScheme) {
 	}
 }
 
+// saveGraphTableTemplate выводит параметры всех узлов схемы, найденных обходом ее графа
+func saveGraphTableTemplate(scheme schemes.ThreeShaftsScheme) {
+	var inserter = templ.NewDataInserter(
+		templatePath(graphTableTemplate),
+		buildDir+"/"+graphTableOut,
+	)
+	if err := inserter.Insert(dataframes.NewGraphDF(scheme)); err != nil {
+		panic(err)
+	}
+}
+
 func solveParticularScheme(scheme schemes.ThreeShaftsScheme, lowPiStag, highPiStag float64) {
 	scheme.LPC().SetPiStag(lowPiStag)
 	scheme.HPC().SetPiStag(highPiStag)
@@ -38,7 +49,7 @@ func solveParticularScheme(scheme schemes.ThreeShaftsScheme, lowPiStag, highPiSt
 
 func saveVariantTemplate(schemeData []core.DoubleCompressorDataPoint) {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+variantTemplate,
+		templatePath(variantTemplate),
 		buildDir+"/"+variantOut,
 	)
 	var df = dataframes.VariantDF{
@@ -55,11 +66,11 @@ func saveVariantTemplate(schemeData []core.DoubleCompressorDataPoint) {
 
 func saveInputTemplates() {
 	var cycleInputInserter = templ.NewDataInserter(
-		templatesDir+"/"+cycleInputTemplate,
+		templatePath(cycleInputTemplate),
 		buildDir+"/"+cycleInputOut,
 	)
 	var projectInputInserter = templ.NewDataInserter(
-		templatesDir+"/"+projectInputTemplate,
+		templatePath(projectInputTemplate),
 		buildDir+"/"+projectInputOut,
 	)
 
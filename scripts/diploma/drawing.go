@@ -0,0 +1,33 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/drawing"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
+)
+
+func saveTriangleDrawings(profiler profilers.Profiler, namePrefix string) {
+	for _, hRel := range []float64{0, 0.5, 1} {
+		var d = drawing.NewTrianglesDrawing(
+			drawing.NewTriangle(profiler.InletTriangle(hRel)),
+			drawing.NewTriangle(profiler.OutletTriangle(hRel)),
+		)
+		saveDrawing(d, fmt.Sprintf("%s_%.0f", namePrefix, hRel*100))
+	}
+}
+
+func saveDrawing(d *drawing.Drawing, name string) {
+	if err := drawing.SaveSVG(dataDir+"/"+name+".svg", d); err != nil {
+		panic(err)
+	}
+	if err := drawing.SaveDXF(dataDir+"/"+name+".dxf", d); err != nil {
+		panic(err)
+	}
+}
+
+func mustDrawing(d *drawing.Drawing, err error) *drawing.Drawing {
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
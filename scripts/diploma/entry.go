@@ -2,19 +2,25 @@ package diploma
 
 import (
 	"fmt"
+	cooling2 "github.com/Sovianum/cooling-course-project/core/cooling"
+	"github.com/Sovianum/cooling-course-project/core/cooling/integration"
+	"github.com/Sovianum/cooling-course-project/core/cooling/nusselt"
+	"github.com/Sovianum/cooling-course-project/core/life"
+	"github.com/Sovianum/cooling-course-project/core/midall"
 	"github.com/Sovianum/cooling-course-project/core/midall/inited"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
 	"github.com/Sovianum/cooling-course-project/core/schemes/s3n"
 	"github.com/Sovianum/cooling-course-project/io"
 	"github.com/Sovianum/cooling-course-project/postprocessing/builder"
+	"github.com/Sovianum/cooling-course-project/postprocessing/plotting"
 	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
 	"github.com/Sovianum/turbocycle/common"
 	"github.com/Sovianum/turbocycle/impl/stage/geometry"
 	"github.com/Sovianum/turbocycle/impl/stage/states"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
 	"github.com/Sovianum/turbocycle/utils/turbine/geom"
 	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
 	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
-	"os"
-	"os/exec"
 )
 
 const (
@@ -29,11 +35,15 @@ const (
 	piStepNum = 100
 
 	templatesDir = "postprocessing/templates"
+	configDir    = "config"
+	mathJaxPath  = "postprocessing/htmlreport/mathjax/tex-svg-full.js" // сборка MathJax из пакета mathjax@3
 
 	buildDir = "/home/artem/gowork/src/github.com/Sovianum/cooling-course-project/build"
 	dataDir  = "build/data/"
 	imgDir   = "build/img"
 
+	texEngine = "" // пусто - первый найденный движок или docker
+
 	projectInputTemplate = "project_input_data_template.tex"
 	projectInputOut      = "project_input_data.tex"
 
@@ -49,9 +59,27 @@ const (
 	rootTemplate = "root.tex"
 	rootOut      = "root.tex"
 
+	schemeSummaryTemplate = "scheme_summary_template.tex"
+	schemeSummaryOut      = "scheme_summary.tex"
+	graphTableTemplate    = "graph_table_template.tex"
+	graphTableOut         = "graph_table.tex"
+
+	// расчетные точки остальных схем в сводной таблице
+	summarySinglePi = 8
+	summaryDoublePi = 10
+	summaryPiFactor = 0.5
+
+	htmlReportOut = "report.html"
+
+	summaryTitle = "Сводка по проекту"
+	summaryOut   = "summary.md"
+
 	titleTemplate = "title.tex"
 	titleOut      = "title.tex"
 
+	statorProfilerSpec = "profilers/stator.json"
+	rotorProfilerSpec  = "profilers/rotor.json"
+
 	turbineStageTemplate = "mean_line_calc_template.tex"
 	turbineStageOut      = "mean_line_calc.tex"
 
@@ -64,9 +92,14 @@ const (
 	hpcTotalTableTemplate = "hpc_total_table_template.tex"
 	hpcTotalTableOut      = "hpc_total_table.tex"
 
+	compressorFitTemplate = "compressor_fit_template.tex"
+	compressorFitOut      = "compressor_fit.tex"
+
 	turbineTotalTableTemplate = "turbine_total_table_template.tex"
 	turbineTotalTableOut      = "turbine_total_table.tex"
 
+	ftTotalTableTemplate = "ft_total_table_template.tex"
+
 	profilingTemplate = "profiling_template.tex"
 	profilingOut      = "profiling.tex"
 
@@ -76,18 +109,74 @@ const (
 	cooling2Template = "cooling_calc2_template.tex"
 	cooling2Out      = "cooling_calc2.tex"
 
+	coolingRotorPSData = "cooling_rotor_ps.json"
+	coolingRotorSSData = "cooling_rotor_ss.json"
+
+	conductionCSVData = "conduction_2d.csv"
+	conductionVTKData = "conduction_2d.vtk"
+
+	lifeTemplate = "life_calc_template.tex"
+	lifeOut      = "life_calc.tex"
+
+	rotorCoolingTemplate = "rotor_cooling_template.tex"
+	rotorCoolingOut      = "rotor_cooling.tex"
+
+	profileQualityTemplate = "profile_quality_template.tex"
+	profileQualityOut      = "profile_quality.tex"
+
+	compressorProfileQualityOut = "compressor_profile_quality.tex"
+
 	cooling2NoFrontPSData = "cooling_2_no_front_ps.json"
 	cooling2NoFrontSSData = "cooling_2_no_front_ss.json"
 
 	cooling2FrontPSData = "cooling_2_front_ps.json"
 	cooling2FrontSSData = "cooling_2_front_ss.json"
 
+	odeConvergencePSData = "ode_convergence_ps.csv"
+
+	statorBlade3DData = "stator_blade_3d"
+	rotorBlade3DData  = "rotor_blade_3d"
+
+	compressorMeridionalDrawing = "compressor_meridional"
+	turbineMeridionalDrawing    = "turbine_meridional"
+	rotorTrianglesDrawing       = "rotor_triangles"
+	compressorFlowPathData      = "compressor_flow_path.csv"
+	turbineFlowPathData         = "turbine_flow_path.csv"
+
+	statorPassageData = "stator_passage.csv"
+	rotorPassageData  = "rotor_passage.csv"
+
+	equilibriumData      = "radial_equilibrium.csv"
+	equilibriumTemplate  = "radial_equilibrium_template.tex"
+	equilibriumOut       = "radial_equilibrium.tex"
+	equilibriumPointNum  = 51
+	equilibriumTableStep = 5 // в таблицу отчета попадает каждая пятая точка
+
+	turbineLossData        = "turbine_losses.csv"
+	turbineLossTemplate    = "turbine_losses_template.tex"
+	turbineLossOut         = "turbine_losses.tex"
+	compressorLossData     = "compressor_losses.csv"
+	compressorLossTemplate = "compressor_losses_template.tex"
+	compressorLossOut      = "compressor_losses.tex"
+
 	inletAngleData  = "inlet_angle.csv"
 	outletAngleData = "outlet_angle.csv"
 
+	// модели потерь; при useLossModel распределения КПД компрессоров и phi, psi турбин по ступеням берутся из них
+	// без повторного согласования с циклом
+	useLossModel             = false
+	lossStatorThicknessRel   = 0.2
+	lossRotorThicknessRel    = 0.25
+	lossStatorTEThicknessRel = 0.02
+	lossRotorTEThicknessRel  = 0.025
+
+	compressorTipGapRel = 0.01
+	maxDiffusionFactor  = 0.55
+	minDeHaller         = 0.72
+
 	hPointNum       = 50
 	coolAirMassRate = 0.04
-	theta0          = 500
+	theta0          = cooling2.TCoolerInlet
 	gapWidth        = 1e-3
 
 	velocityCoef = 0.98
@@ -97,12 +186,73 @@ const (
 	coolingHoleNum     = 20
 
 	dInlet = 2.2e-3
+
+	rotorCoolingHRel       = 0.5 // сечение, распределение температур в котором выводится на графиках
+	rotorCoolingSectionNum = 5   // число сечений по высоте, в которых рассчитывается охлаждение рабочей лопатки
+
+	ductLengthRel     = 3
+	ductMaxAreaRatio  = 1.3
+	ductMaxDivergence = 7 // град
+
+	blade3DSectionNum = 21
+	blade3DPointNum   = 200
+	blade3DStacking   = profiling.StackCG // cg, le, te
+	blade3DLean       = 0                 // град
+	blade3DSweep      = 0                 // град
+
+	profileInletEdgeRadius  = 0.05
+	profileOutletEdgeRadius = 0.03
+	profileInletEdgeAngle   = 0.35
+	profileOutletEdgeAngle  = 0.3
+
+	qualitySectionNum          = 11
+	qualityPointNum            = 200
+	qualityMaxIncidence        = 10 // град
+	qualityMaxOutletAngleError = 3  // град
+	qualityMinThicknessRel     = 0.05
+	qualityMaxThicknessRel     = 0.35
+	qualityMinEdgeRadiusRel    = 0.01
+	qualityMaxAreaRatio        = 1 // канал турбинной решетки должен быть конфузорным
+
+	compressorProfileFamily   = profiling.C4 // naca65, c4, dca
+	compressorThicknessRel    = 0.08
+	compressorSolidity        = 1.2
+	compressorProfilePointNum = 60
+	compressorVortexLaw       = profiling.FreeVortexLaw
+	compressorMaxIncidence    = 8 // град
+	compressorMaxThicknessRel = 0.12
+
+	conductionEnabled   = true
+	conductionGridStep  = 1e-4
+	conductionPointNum  = 400
+	conductionRelaxCoef = 1.8
+	conductionPrecision = 1e-3
+	conductionIterLimit = 100000
+
+	gasNuCorrelation = nusselt.IvanovBlade
+
+	odeMethod             = integration.Euler // euler, rk4, rk45
+	odeStep               = 0.001
+	odeTolerance          = 1e-6
+	odeMinStep            = 1e-9
+	odeConvergenceEnabled = true
+	odeConvergenceNum     = 4
+	odeConvergenceFactor  = 4
+
+	lifeMaterial       = life.ZhS6K
+	lifeAreaTaper      = 0.5
+	lifeSectionNum     = 11
+	lifeRequired       = 10e3
+	lifeRequiredCycles = 3e3
 )
 
 func Entry() {
+	checkTemplates()
+
 	io.PrepareDirectories(
 		buildDir, dataDir, imgDir,
 	)
+	var run = startRun()
 	if err := copyPassiveFiles(); err != nil {
 		panic(err)
 	}
@@ -116,17 +266,34 @@ func Entry() {
 
 	solveParticularScheme(scheme, s3n.PiDiplomaLow, s3n.PiDiplomaHigh)
 	saveCycleTemplate(scheme)
-
-	saveCompressorStageTemplate()
-	saveCompressorTotalTableTemplates()
-
-	initedMachines, err := inited.GetInitedStagedNodes()
+	saveSchemeSummaryTemplate(scheme)
+	saveGraphTableTemplate(scheme)
+
+	// fittedMachines подобраны по циклу; модели потерь сравниваются с ними
+	var fittedMachines, initedMachines *midall.StagedScheme3n
+	var err error
+	if useLossModel {
+		fittedMachines, initedMachines, err = inited.GetLossModelStagedNodes(
+			getLossModelConfig(), getCompressorLossModelConfig(),
+		)
+	} else {
+		initedMachines, err = inited.GetInitedStagedNodes()
+		fittedMachines = initedMachines
+	}
 	if err != nil {
 		panic(err)
 	}
+	saveCompressorStageTemplate(initedMachines)
+	saveCompressorTotalTableTemplates(initedMachines)
+	saveCompressorFitTemplate(initedMachines)
+	saveCompressorLossComparison(fittedMachines)
+	saveTurbineLossComparison(fittedMachines)
+	saveFlowPaths(initedMachines)
+	saveCompressorProfiles(initedMachines)
+
 	stage := initedMachines.HPT.Stages()[0]
 	saveTurbineStageTemplate(stage)
-	saveTurbineTotalTableTemplates()
+	saveTurbineTotalTableTemplates(initedMachines)
 
 	statorProfiler := getStatorProfiler(stage)
 	saveProfiles(
@@ -183,12 +350,20 @@ func Entry() {
 		true,
 	)
 
+	saveBlade3D(statorProfiler, stage.GetDataPack().StageGeometry.StatorGeometry(), false, statorBlade3DData)
+	saveBlade3D(rotorProfiler, rotorGeom, true, rotorBlade3DData)
+	saveTriangleDrawings(rotorProfiler, rotorTrianglesDrawing)
+
+	equilibrium := getStageEquilibrium(stage, rotorProfiler)
+	saveStageEquilibrium(equilibrium)
+
 	inletGasProfiler, outletGasProfiler := getGasProfilers(stage, rotorProfiler)
 	fmt.Println(profilers.Reactivity(0, 0.5, inletGasProfiler, outletGasProfiler))
 	fmt.Println(profilers.Reactivity(0.5, 0.5, inletGasProfiler, outletGasProfiler))
 	fmt.Println(profilers.Reactivity(1, 0.5, inletGasProfiler, outletGasProfiler))
 
 	saveProfilingTemplate()
+	saveProfileQualityTemplate(stage, statorProfiler, rotorProfiler)
 
 	statorMidProfile := profiles.NewBladeProfileFromProfiler(
 		0.5,
@@ -199,10 +374,11 @@ func Entry() {
 	stagePack := stage.GetDataPack()
 	statorMidProfile.Transform(geom.Scale(geometry.ChordProjection(stagePack.StageGeometry.StatorGeometry())))
 
-	gapCalculator := getGapCalculator(stage, statorMidProfile)
+	gasCorrelation := getGasNuCorrelation()
+	gapCalculator := getGapCalculator(stage, statorMidProfile, gasCorrelation)
 
 	noFrontGapPack := gapCalculator.GetPack(coolAirMassRate)
-	gapCalcDF := getGapDF(common.LinSpace(0.01, 0.10, 10), gapCalculator)
+	gapCalcDF := getGapDF(common.LinSpace(0.01, 0.10, 10), gapCalculator, gasCorrelation)
 	saveCooling1Template(gapCalcDF)
 
 	psTemperatureSystemNoFront := getPSConvFilmTemperatureSystem(
@@ -216,8 +392,9 @@ func Entry() {
 			{30e-3, 0.5e-3},
 			{37e-3, 0.40e-3},
 		},
+		getODEConfig(),
 	)
-	psSolutionNoFront := psTemperatureSystemNoFront.Solve(0, theta0, 1, 0.001)
+	psSolutionNoFront := psTemperatureSystemNoFront.Solve(0, theta0, 1, odeStep)
 	saveCoolingSolution(psSolutionNoFront, cooling2NoFrontPSData)
 
 	ssTemperatureSystemNoFront := getSSConvFilmTemperatureSystem(
@@ -233,31 +410,43 @@ func Entry() {
 			{38e-3, 0.35e-3},
 			{43e-3, 0.45e-3},
 		},
+		getODEConfig(),
 	)
-	ssSolutionNoFront := ssTemperatureSystemNoFront.Solve(0, theta0, 1, 0.001)
+	ssSolutionNoFront := ssTemperatureSystemNoFront.Solve(0, theta0, 1, odeStep)
 	saveCoolingSolution(ssSolutionNoFront, cooling2NoFrontSSData)
 
 	minCoolAirMassRate := coolAirMassRate * 0.91
 	frontGapPack := gapCalculator.GetPack(minCoolAirMassRate)
 	tempProfileDF := getTempProfileDF(gapCalcDF, stage, statorMidProfile, psSolutionNoFront, ssSolutionNoFront)
 	saveCooling2Template(tempProfileDF)
-
+	saveSummary(scheme, initedMachines, gapCalcDF, tempProfileDF)
+
+	psFrontSlits := []SlitGeom{
+		{0, 0.15e-3},
+		{10e-3, 0.30e-3},
+		{18e-3, 0.30e-3},
+		{25e-3, 0.55e-3},
+		{36.5e-3, 0.53e-3},
+	}
 	psTemperatureSystemFront := getPSConvFilmTemperatureSystem(
 		minCoolAirMassRate,
 		frontGapPack.AlphaGas,
 		stage,
 		statorMidProfile,
-		[]SlitGeom{
-			{0, 0.15e-3},
-			{10e-3, 0.30e-3},
-			{18e-3, 0.30e-3},
-			{25e-3, 0.55e-3},
-			{36.5e-3, 0.53e-3},
-		},
+		psFrontSlits,
+		getODEConfig(),
 	)
-	psSolutionFront := psTemperatureSystemFront.Solve(0, theta0, 1, 0.001)
+	psSolutionFront := psTemperatureSystemFront.Solve(0, theta0, 1, odeStep)
 	saveCoolingSolution(psSolutionFront, cooling2FrontPSData)
 
+	if odeConvergenceEnabled {
+		saveODEConvergence(func(conf integration.Config) profile.TemperatureSystem {
+			return getPSConvFilmTemperatureSystem(
+				minCoolAirMassRate, frontGapPack.AlphaGas, stage, statorMidProfile, psFrontSlits, conf,
+			)
+		}, odeConvergencePSData)
+	}
+
 	ssTemperatureSystemFront := getSSConvFilmTemperatureSystem(
 		minCoolAirMassRate,
 		frontGapPack.AlphaGas,
@@ -271,15 +460,34 @@ func Entry() {
 			{35e-3, 0.55e-3},
 			{43.5e-3, 0.55e-3},
 		},
+		getODEConfig(),
 	)
-	ssSolutionFront := ssTemperatureSystemFront.Solve(0, theta0, 1, 0.001)
+	ssSolutionFront := ssTemperatureSystemFront.Solve(0, theta0, 1, odeStep)
 	saveCoolingSolution(ssSolutionFront, cooling2FrontSSData)
 
+	if conductionEnabled {
+		saveConductionField(
+			minCoolAirMassRate, frontGapPack.AlphaGas, stage, statorMidProfile, psSolutionFront, ssSolutionFront,
+		)
+	}
+
+	rotorSections := getRotorSections(stage, rotorProfiler, gasCorrelation)
+	rotorMidSection := nearestRotorSection(rotorSections, rotorCoolingHRel)
+	saveCoolingSolution(rotorMidSection.psSolution, coolingRotorPSData)
+	saveCoolingSolution(rotorMidSection.ssSolution, coolingRotorSSData)
+
+	rotorCoolingDF := getRotorCoolingDF(stage, rotorProfiler, rotorSections)
+	saveRotorCoolingTemplate(rotorCoolingDF)
+	lifeDF := getLifeDF(stage, rotorCoolingDF)
+	saveLifeTemplate(lifeDF)
+	saveHTMLReport(scheme, initedMachines, gapCalcDF, tempProfileDF, equilibrium, rotorCoolingDF, lifeDF)
+
 	saveRootTemplate()
 	saveTitleTemplate()
 
 	buildPlots()
 	buildReport()
+	saveManifest(run)
 }
 
 func copyPassiveFiles() error {
@@ -298,16 +506,9 @@ func copyPassiveFiles() error {
 		"cross.png", "profile.png", "blade.png", "tech_1.png", "tech_2.png",
 		"cool.png", "comp.png", "cycles.png", "parameters.png", "eco.png",
 	}
-	templateNames := []string{
-		"ecology.tex", "economics.tex", "ending.tex",
-		"referat.tex", "technology.tex", "intro.tex",
-		"literature.tex", "cycle_comparison.tex", "profiling_template.tex",
-		"cooling_optimization.tex", "application.tex",
-	}
 	docNames := []string{"title_page.pdf"}
 
 	imgSrc := "postprocessing/media/img/"
-	templateSrc := "postprocessing/templates/"
 	docSrc := "postprocessing/media/"
 
 	for _, name := range imgNames {
@@ -315,8 +516,8 @@ func copyPassiveFiles() error {
 			return err
 		}
 	}
-	for _, name := range templateNames {
-		if err := io.CopyFile(templateSrc+name, "build/"+name); err != nil {
+	for _, name := range passiveTemplates {
+		if err := io.CopyFile(templatePath(name), "build/"+name); err != nil {
 			return err
 		}
 	}
@@ -329,23 +530,24 @@ func copyPassiveFiles() error {
 }
 
 func buildReport() {
-	if err := builder.BuildLatex(buildDir, rootOut); err != nil {
+	var report, err = builder.Build(builder.Config{RootDir: buildDir, RootFile: rootOut, Engine: texEngine})
+	if err != nil {
 		panic(err)
 	}
+	if len(report.OverfullBoxes) > 0 {
+		fmt.Printf("%d overfull boxes\n", len(report.OverfullBoxes))
+	}
 }
 
 func buildPlots() {
-	var cmd = exec.Command("./plot_all.py", imgDir, dataDir)
-	cmd.Stdout = os.Stdout
-	var err = cmd.Run()
-	if err != nil {
+	if err := plotting.PlotAll(imgDir, dataDir, plotting.DefaultOptions()); err != nil {
 		panic(err)
 	}
 }
 
 func saveRootTemplate() {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+rootTemplate,
+		templatePath(rootTemplate),
 		buildDir+"/"+rootOut,
 	)
 	if err := inserter.Insert(nil); err != nil {
@@ -355,7 +557,7 @@ func saveRootTemplate() {
 
 func saveTitleTemplate() {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+titleTemplate,
+		templatePath(titleTemplate),
 		buildDir+"/"+titleOut,
 	)
 	if err := inserter.Insert(nil); err != nil {
@@ -0,0 +1,90 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	states2 "github.com/Sovianum/turbocycle/impl/engine/states"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
+	"math"
+)
+
+func saveStageEquilibrium(result profiling.StageEquilibrium) {
+	for _, warning := range result.Warnings {
+		fmt.Println("radial equilibrium warning:", warning)
+	}
+
+	var inlet, outlet = result.Inlet, result.Outlet
+	var matrix = make([][]float64, len(inlet.HRel))
+	for i, hRel := range inlet.HRel {
+		matrix[i] = []float64{
+			hRel,
+			inlet.R[i], inlet.CA[i], inlet.CU[i], inlet.P[i],
+			outlet.R[i], outlet.CA[i], outlet.CU[i], outlet.P[i],
+			result.Reactivity[i],
+		}
+	}
+	if err := profiling.SaveMatrix(dataDir+"/"+equilibriumData, matrix); err != nil {
+		panic(err)
+	}
+
+	var inserter = templ.NewDataInserter(
+		templatePath(equilibriumTemplate),
+		buildDir+"/"+equilibriumOut,
+	)
+	if err := inserter.Insert(dataframes.NewRadialEquilibriumDF(result, equilibriumTableStep)); err != nil {
+		panic(err)
+	}
+}
+
+// getStageEquilibrium решает уравнение радиального равновесия при закрутке из профайлера рабочего колеса.
+// Работа по высоте находится по уравнению Эйлера, потери в венцах - при коэффициентах потерь
+// среднего радиуса, то есть пропорционально квадратам скоростей c_1 и w_2.
+func getStageEquilibrium(stage turbine.StageNode, rotorProfiler profilers.Profiler) profiling.StageEquilibrium {
+	var pack = stage.GetDataPack()
+	var gas = stage.GasInput().GetState().(states2.GasPortState).Gas
+	var rotorGeom = pack.StageGeometry.RotorGeometry()
+	var c1Mean, w2Mean = pack.RotorInletTriangle.C(), pack.RotorOutletTriangle.W()
+
+	var result, err = profiling.SolveStageEquilibrium(profiling.EquilibriumConfig{
+		PointNum: equilibriumPointNum,
+		Omega:    pack.RPM * math.Pi / 30,
+
+		InletSwirl: func(hRel float64) float64 {
+			return rotorProfiler.InletTriangle(hRel).CU()
+		},
+		Work: func(hRel float64) float64 {
+			var inlet, outlet = rotorProfiler.InletTriangle(hRel), rotorProfiler.OutletTriangle(hRel)
+			return -(inlet.CU()*inlet.U() + outlet.CU()*outlet.U())
+		},
+		StatorLoss: func(hRel float64) float64 {
+			var cRel = rotorProfiler.InletTriangle(hRel).C() / c1Mean
+			return pack.StatorSpecificLoss * cRel * cRel
+		},
+		RotorLoss: func(hRel float64) float64 {
+			var wRel = rotorProfiler.OutletTriangle(hRel).W() / w2Mean
+			return pack.RotorSpecificLoss * wRel * wRel
+		},
+
+		Inlet: profiling.StationConditions{
+			RHub:    rotorGeom.InnerProfile().Diameter(0) / 2,
+			RTip:    rotorGeom.OuterProfile().Diameter(0) / 2,
+			CAMean:  pack.RotorInletTriangle.CA(),
+			Density: pack.P1 / (gas.R() * pack.T1),
+			PMean:   pack.P1,
+		},
+		Outlet: profiling.StationConditions{
+			RHub:    rotorGeom.InnerProfile().Diameter(rotorGeom.XBladeOut()) / 2,
+			RTip:    rotorGeom.OuterProfile().Diameter(rotorGeom.XBladeOut()) / 2,
+			CAMean:  pack.RotorOutletTriangle.CA(),
+			Density: pack.Density2,
+			PMean:   pack.P2,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
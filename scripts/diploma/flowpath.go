@@ -0,0 +1,54 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/flowpath"
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/cooling-course-project/postprocessing/drawing"
+	"github.com/Sovianum/turbocycle/common"
+)
+
+func saveFlowPaths(machines *midall.StagedScheme3n) {
+	var compressorPath = getFlowPath([]flowpath.Component{
+		flowpath.CompressorComponent("КНД", machines.LPC),
+		flowpath.CompressorComponent("КВД", machines.HPC),
+	})
+	saveFlowPath(compressorPath, compressorFlowPathData, compressorMeridionalDrawing)
+
+	var turbinePath = getFlowPath([]flowpath.Component{
+		flowpath.TurbineComponent("ТВД", machines.HPT),
+		flowpath.TurbineComponent("ТНД", machines.LPT),
+		flowpath.TurbineComponent("СТ", machines.FT),
+	})
+	saveFlowPath(turbinePath, turbineFlowPathData, turbineMeridionalDrawing)
+}
+
+func getFlowPath(components []flowpath.Component) flowpath.FlowPath {
+	var fp, err = flowpath.Build(components, flowpath.Config{
+		DuctLengthRel: ductLengthRel,
+		MaxAreaRatio:  ductMaxAreaRatio,
+		MaxDivergence: common.ToRadians(ductMaxDivergence),
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, warning := range fp.Warnings {
+		fmt.Println("flow path warning:", warning)
+	}
+	return fp
+}
+
+func saveFlowPath(fp flowpath.FlowPath, dataName, drawingName string) {
+	var matrix = make([][]float64, len(fp.Segments))
+	for i, s := range fp.Segments {
+		matrix[i] = []float64{
+			s.X0, s.X1, s.DHubIn, s.DHubOut, s.DTipIn, s.DTipOut,
+			s.AreaRatio(), common.ToDegrees(s.DivergenceAngle()),
+		}
+	}
+	if err := profiling.SaveMatrix(dataDir+"/"+dataName, matrix); err != nil {
+		panic(err)
+	}
+	saveDrawing(mustDrawing(drawing.NewFlowPathDrawing(fp)), drawingName)
+}
@@ -0,0 +1,46 @@
+package diploma
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/htmlreport"
+	"github.com/Sovianum/cooling-course-project/postprocessing/plotting"
+	"github.com/Sovianum/turbocycle/library/schemes"
+)
+
+func saveHTMLReport(
+	scheme schemes.ThreeShaftsScheme,
+	machines *midall.StagedScheme3n,
+	gapCalcDF dataframes.GapCalcDF,
+	tempProfileDF dataframes.TProfileCalcDF,
+	equilibrium profiling.StageEquilibrium,
+	rotorCoolingDF dataframes.RotorCoolingDF,
+	lifeDF dataframes.LifeDF,
+) {
+	var report, err = htmlreport.NewReport(htmlreport.Data{
+		Cycle: dataframes.NewThreeShaftsDF(power, etaR, scheme),
+		LPC:   dataframes.NewStagedCompressorDF(machines.LPC),
+		HPC:   dataframes.NewStagedCompressorDF(machines.HPC),
+		Turbine: dataframes.NewStagedTurbineDF(machines.HPT).
+			Join(dataframes.NewStagedTurbineDF(machines.LPT)).
+			Join(dataframes.NewStagedTurbineDF(machines.FT)),
+		Gap:      gapCalcDF,
+		TProfile: tempProfileDF,
+
+		Equilibrium:  dataframes.NewRadialEquilibriumDF(equilibrium, equilibriumTableStep),
+		RotorCooling: rotorCoolingDF,
+		Life:         lifeDF,
+
+		PlotOptions: plotting.DefaultOptions(),
+	})
+	if err != nil {
+		panic(err)
+	}
+	if report.MathJax, err = htmlreport.LoadMathJax(mathJaxPath); err != nil {
+		panic(err)
+	}
+	if err := report.Save(buildDir + "/" + htmlReportOut); err != nil {
+		panic(err)
+	}
+}
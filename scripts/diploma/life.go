@@ -0,0 +1,75 @@
+package diploma
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/interp"
+	"github.com/Sovianum/cooling-course-project/core/life"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"math"
+)
+
+func saveLifeTemplate(df dataframes.LifeDF) {
+	var inserter = templ.NewDataInserter(
+		templatePath(lifeTemplate),
+		buildDir+"/"+lifeOut,
+	)
+	if err := inserter.Insert(df); err != nil {
+		panic(err)
+	}
+}
+
+func getLifeDF(stage turbine.StageNode, rotorCoolingDF dataframes.RotorCoolingDF) dataframes.LifeDF {
+	var conf = getLifeConfig()
+	return dataframes.NewLifeDF(conf, getLifeResult(stage, conf, rotorCoolingDF))
+}
+
+// getLifeResult оценивает ресурс по температурам стенки, рассчитанным в сечениях rotorCoolingDF;
+// между сечениями температуры интерполируются линейно
+func getLifeResult(stage turbine.StageNode, conf life.Config, rotorCoolingDF dataframes.RotorCoolingDF) life.Result {
+	var hRelArr = rotorCoolingDF.HRel()
+	var tMaxArr, dtArr = rotorCoolingDF.TWallMax(), rotorCoolingDF.DTWall()
+	var result, err = life.AssessRotor(
+		stage, conf,
+		func(hRel float64) float64 {
+			return interp.Linear(hRelArr, tMaxArr, hRel)
+		},
+		func(hRel float64) float64 {
+			return interp.Linear(hRelArr, dtArr, hRel)
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func getLifeConfig() life.Config {
+	var material, err = life.GetMaterial(lifeMaterial)
+	if err != nil {
+		panic(err)
+	}
+	return life.Config{
+		Material:       material,
+		AreaTaper:      lifeAreaTaper,
+		SectionNum:     lifeSectionNum,
+		RequiredLife:   lifeRequired,
+		RequiredCycles: lifeRequiredCycles,
+	}
+}
+
+func maxValue(arr []float64) float64 {
+	var result = math.Inf(-1)
+	for _, v := range arr {
+		result = math.Max(result, v)
+	}
+	return result
+}
+
+func minValue(arr []float64) float64 {
+	var result = math.Inf(1)
+	for _, v := range arr {
+		result = math.Min(result, v)
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/io"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+)
+
+const sourceDir = "scripts/diploma"
+
+// manifestSources - каталоги кода, от которого зависят результаты; настройки решателей заданы константами
+// в sourceDir, поэтому их изменение без коммита также делает результаты устаревшими
+var manifestSources = []string{"core", "io", "postprocessing", sourceDir}
+
+// manifestInputs - каталоги исходных данных расчета
+var manifestInputs = []string{configDir, templatesDir, mathJaxPath}
+
+var manifestOutputs = []string{buildDir, dataDir, imgDir}
+
+func manifestSettings() map[string]interface{} {
+	return map[string]interface{}{
+		"lang":                string(locale.Current()),
+		"relaxCoef":           relaxCoef,
+		"iterNum":             iterNum,
+		"precision":           precision,
+		"useLossModel":        useLossModel,
+		"gasNuCorrelation":    gasNuCorrelation,
+		"odeMethod":           odeMethod,
+		"odeStep":             odeStep,
+		"odeTolerance":        odeTolerance,
+		"odeMinStep":          odeMinStep,
+		"conductionRelaxCoef": conductionRelaxCoef,
+		"conductionPrecision": conductionPrecision,
+		"conductionIterLimit": conductionIterLimit,
+		"conductionGridStep":  conductionGridStep,
+	}
+}
+
+func startRun() *io.Run {
+	var run, err = io.NewRun(manifestSettings(), manifestSources, manifestInputs, manifestOutputs)
+	if err != nil {
+		panic(err)
+	}
+	return run
+}
+
+func saveManifest(run *io.Run) {
+	var manifest, err = run.Finish()
+	if err != nil {
+		panic(err)
+	}
+	if err := manifest.Save(buildDir + "/" + io.ManifestName); err != nil {
+		panic(err)
+	}
+	fmt.Printf("manifest: %d inputs, %d outputs\n", len(manifest.Inputs), len(manifest.Outputs))
+}
+
+// VerifyOutputs сравнивает манифест последнего запуска с текущими исходными данными, настройками и результатами
+func VerifyOutputs() ([]error, error) {
+	var manifest, err = io.LoadManifest(buildDir + "/" + io.ManifestName)
+	if err != nil {
+		return nil, err
+	}
+	return manifest.Verify(manifestSettings()), nil
+}
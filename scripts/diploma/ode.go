@@ -0,0 +1,42 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/cooling/integration"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
+)
+
+func getODEConfig() integration.Config {
+	return integration.Config{
+		Method:    odeMethod,
+		Step:      odeStep,
+		Tolerance: odeTolerance,
+		MinStep:   odeMinStep,
+	}
+}
+
+func saveODEConvergence(sysFunc func(conf integration.Config) profile.TemperatureSystem, fileName string) {
+	var confs = integration.RefinedConfigs(getODEConfig(), odeConvergenceNum, odeConvergenceFactor)
+	var points, err = integration.ConvergenceStudy(func(conf integration.Config) ([]float64, []float64, error) {
+		var solution = sysFunc(conf).Solve(0, theta0, 1, conf.Step)
+		return solution.LengthCoord, solution.WallTemperature, nil
+	}, confs)
+	if err != nil {
+		panic(err)
+	}
+
+	var matrix = make([][]float64, len(points))
+	for i, point := range points {
+		fmt.Printf(
+			"%s: step = %.2e\ttol = %.2e\tpoints = %d\tmax diff = %.3f\n",
+			point.Config.Method, point.Config.Step, point.Config.Tolerance, point.PointNum, point.MaxDiff,
+		)
+		matrix[i] = []float64{
+			point.Config.Step, point.Config.Tolerance, float64(point.PointNum), point.YEnd, point.MaxDiff,
+		}
+	}
+	if err := profiling.SaveMatrix(dataDir+"/"+fileName, matrix); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,73 @@
+package diploma
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/Sovianum/turbocycle/common"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
+)
+
+var profileEdgeConfig = profiling.EdgeConfig{
+	InletEdgeRadius:  profileInletEdgeRadius,
+	OutletEdgeRadius: profileOutletEdgeRadius,
+	InletEdgeAngle:   profileInletEdgeAngle,
+	OutletEdgeAngle:  profileOutletEdgeAngle,
+}
+
+func saveProfileQualityTemplate(stage turbine.StageNode, statorProfiler, rotorProfiler profilers.Profiler) {
+	var inserter = templ.NewDataInserter(
+		templatePath(profileQualityTemplate),
+		buildDir+"/"+profileQualityOut,
+	)
+	var statorDF = getProfileQualityDF(
+		statorProfiler, stage.StageGeomGen().StatorGenerator(), false, "stator", locale.T("сопловой аппарат"),
+	)
+	var rotorDF = getProfileQualityDF(
+		rotorProfiler, stage.StageGeomGen().RotorGenerator(), true, "rotor", locale.T("рабочее колесо"),
+	)
+	if err := profiling.SaveMatrix(dataDir+"/"+statorPassageData, statorDF.PassageMatrix()); err != nil {
+		panic(err)
+	}
+	if err := profiling.SaveMatrix(dataDir+"/"+rotorPassageData, rotorDF.PassageMatrix()); err != nil {
+		panic(err)
+	}
+	if err := inserter.Insert([]dataframes.ProfileQualityDF{statorDF, rotorDF}); err != nil {
+		panic(err)
+	}
+}
+
+func getProfileQualityDF(
+	profiler profilers.Profiler,
+	geomGen turbine.BladingGeometryGenerator,
+	isRotor bool,
+	name, title string,
+) dataframes.ProfileQualityDF {
+	var hRelArr = common.LinSpace(0, 1, qualitySectionNum)
+	var sections = make([]profiling.CascadeSection, len(hRelArr))
+	for i, hRel := range hRelArr {
+		sections[i] = profiling.NewCascadeSection(
+			profiler, geomGen, hRel, profileEdgeConfig, isRotor, qualityPointNum,
+		)
+	}
+
+	var limits = getQualityLimits()
+	var result, err = profiling.AnalyzeSections(sections, limits)
+	if err != nil {
+		panic(err)
+	}
+	return dataframes.NewProfileQualityDF(name, title, limits, result)
+}
+
+func getQualityLimits() profiling.QualityLimits {
+	return profiling.QualityLimits{
+		MaxIncidence:        common.ToRadians(qualityMaxIncidence),
+		MaxOutletAngleError: common.ToRadians(qualityMaxOutletAngleError),
+		MinThicknessRel:     qualityMinThicknessRel,
+		MaxThicknessRel:     qualityMaxThicknessRel,
+		MinEdgeRadiusRel:    qualityMinEdgeRadiusRel,
+		MaxAreaRatio:        qualityMaxAreaRatio,
+	}
+}
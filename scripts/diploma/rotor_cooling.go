@@ -0,0 +1,239 @@
+package diploma
+
+import (
+	cooling2 "github.com/Sovianum/cooling-course-project/core/cooling"
+	"github.com/Sovianum/cooling-course-project/core/cooling/nusselt"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/Sovianum/turbocycle/common"
+	states2 "github.com/Sovianum/turbocycle/impl/engine/states"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/gap"
+	"github.com/Sovianum/turbocycle/utils/turbine/cooling/profile"
+	"github.com/Sovianum/turbocycle/utils/turbine/geom"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
+	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
+	"math"
+)
+
+func getRotorProfile(stage turbine.StageNode, rotorProfiler profilers.Profiler, hRel float64) profiles.BladeProfile {
+	var rotorProfile = profiles.NewBladeProfileFromProfiler(
+		hRel,
+		0.01, 0.01,
+		0.2, 0.2,
+		rotorProfiler,
+	)
+	var stagePack = stage.GetDataPack()
+	rotorProfile.Transform(geom.Scale(geometry.ChordProjection(stagePack.StageGeometry.RotorGeometry())))
+	return rotorProfile
+}
+
+func saveRotorCoolingTemplate(df dataframes.RotorCoolingDF) {
+	var inserter = templ.NewDataInserter(
+		templatePath(rotorCoolingTemplate),
+		buildDir+"/"+rotorCoolingOut,
+	)
+	if err := inserter.Insert(df); err != nil {
+		panic(err)
+	}
+}
+
+// rotorSection - решение задачи охлаждения рабочей лопатки в одном сечении по высоте
+type rotorSection struct {
+	hRel       float64
+	psSolution profile.TemperatureSolution
+	ssSolution profile.TemperatureSolution
+	gapPack    gap.DataPack
+}
+
+// getRotorSections решает задачу охлаждения рабочей лопатки в rotorCoolingSectionNum сечениях по высоте
+func getRotorSections(
+	stage turbine.StageNode,
+	rotorProfiler profilers.Profiler,
+	correlation nusselt.Correlation,
+) []rotorSection {
+	var result []rotorSection
+	for _, hRel := range common.LinSpace(0, 1, rotorCoolingSectionNum) {
+		var psSolution, ssSolution, gapPack = getRotorSolutions(stage, rotorProfiler, correlation, hRel)
+		result = append(result, rotorSection{
+			hRel:       hRel,
+			psSolution: psSolution,
+			ssSolution: ssSolution,
+			gapPack:    gapPack,
+		})
+	}
+	return result
+}
+
+// nearestRotorSection возвращает рассчитанное сечение, ближайшее к hRel
+func nearestRotorSection(sections []rotorSection, hRel float64) rotorSection {
+	var result = sections[0]
+	for _, section := range sections[1:] {
+		if math.Abs(section.hRel-hRel) < math.Abs(result.hRel-hRel) {
+			result = section
+		}
+	}
+	return result
+}
+
+func getRotorCoolingDF(
+	stage turbine.StageNode,
+	rotorProfiler profilers.Profiler,
+	sections []rotorSection,
+) dataframes.RotorCoolingDF {
+	var result = dataframes.RotorCoolingDF{AirMassRate: coolAirMassRate}
+	for i, section := range sections {
+		var gasState, err = cooling2.NewRotorGasState(stage, rotorProfiler, section.hRel)
+		if err != nil {
+			panic(err)
+		}
+		coolerState, err := cooling2.NewRotorCoolerState(stage, rotorProfiler, theta0, section.hRel)
+		if err != nil {
+			panic(err)
+		}
+
+		var psSolution, ssSolution = section.psSolution, section.ssSolution
+		var tMax = math.Max(maxValue(psSolution.WallTemperature), maxValue(ssSolution.WallTemperature))
+		var tMin = math.Min(minValue(psSolution.WallTemperature), minValue(ssSolution.WallTemperature))
+		result.Sections = append(result.Sections, dataframes.RotorCoolingSection{
+			Id:       i + 1,
+			HRel:     section.hRel,
+			Radius:   gasState.Radius,
+			W:        gasState.W,
+			TStagW:   gasState.TStagW,
+			PStagW:   gasState.PStagW,
+			TCooler:  coolerState.T,
+			PCooler:  coolerState.P,
+			AlphaGas: section.gapPack.AlphaGas,
+			TWallMax: tMax,
+			DTWall:   tMax - tMin,
+		})
+	}
+	return result
+}
+
+// getRotorSolutions рассчитывает температурное состояние корытца и спинки рабочей лопатки в сечении hRel
+func getRotorSolutions(
+	stage turbine.StageNode,
+	rotorProfiler profilers.Profiler,
+	correlation nusselt.Correlation,
+	hRel float64,
+) (profile.TemperatureSolution, profile.TemperatureSolution, gap.DataPack) {
+	var rotorProfile = getRotorProfile(stage, rotorProfiler, hRel)
+	var gapPack = getRotorGapCalculator(stage, rotorProfiler, rotorProfile, correlation, hRel).GetPack(coolAirMassRate)
+	if gapPack.Err != nil {
+		panic(gapPack.Err)
+	}
+	printNuWarning(correlation, gapPack.ReGas)
+	var theta0 = getRotorTheta0(stage, rotorProfiler, hRel)
+
+	var psSolution = getRotorPSConvTemperatureSystem(
+		coolAirMassRate, gapPack.AlphaGas, stage, rotorProfiler, rotorProfile, hRel,
+	).Solve(0, theta0, 1, odeStep)
+	var ssSolution = getRotorSSConvTemperatureSystem(
+		coolAirMassRate, gapPack.AlphaGas, stage, rotorProfiler, rotorProfile, hRel,
+	).Solve(0, theta0, 1, odeStep)
+	return psSolution, ssSolution, gapPack
+}
+
+func getRotorTheta0(stage turbine.StageNode, rotorProfiler profilers.Profiler, hRel float64) float64 {
+	var gasState, stateErr = cooling2.NewRotorGasState(stage, rotorProfiler, hRel)
+	if stateErr != nil {
+		panic(stateErr)
+	}
+	var pumping, pumpingErr = cooling2.NewRotorPumping(stage)
+	if pumpingErr != nil {
+		panic(pumpingErr)
+	}
+	return pumping.CoolerTemperature(theta0, gasState.Radius)
+}
+
+func getRotorGapCalculator(
+	stage turbine.StageNode,
+	rotorProfiler profilers.Profiler,
+	profile profiles.BladeProfile,
+	correlation nusselt.Correlation,
+	hRel float64,
+) gap.GapCalculator {
+	if result, err := cooling2.GetInitedRotorGapCalculator(stage, rotorProfiler, profile, correlation, hRel); err != nil {
+		panic(err)
+	} else {
+		return result
+	}
+}
+
+func getRotorSSConvTemperatureSystem(
+	coolMassRate,
+	meanAlphaGas float64,
+	stage turbine.StageNode,
+	rotorProfiler profilers.Profiler,
+	profile profiles.BladeProfile,
+	hRel float64,
+) profile.TemperatureSystem {
+	var segment = profiles.SSSegment(profile, 0.5, 0.5)
+	var alphaGasFunc, alphaAirFunc = getRotorAlphaLaws(
+		coolMassRate, meanAlphaGas, stage, rotorProfiler, profile, cooling2.SSProfileGasAlphaLaw, hRel,
+	)
+	if system, err := cooling2.GetInitedRotorConvTemperatureSystem(
+		coolMassRate, stage, rotorProfiler, segment, alphaAirFunc, alphaGasFunc, getODEConfig(), hRel,
+	); err != nil {
+		panic(err)
+	} else {
+		return system
+	}
+}
+
+func getRotorPSConvTemperatureSystem(
+	coolMassRate,
+	meanAlphaGas float64,
+	stage turbine.StageNode,
+	rotorProfiler profilers.Profiler,
+	profile profiles.BladeProfile,
+	hRel float64,
+) profile.TemperatureSystem {
+	var segment = profiles.PSSegment(profile, 0.5, 0.5)
+	var alphaGasFunc, alphaAirFunc = getRotorAlphaLaws(
+		coolMassRate, meanAlphaGas, stage, rotorProfiler, profile, cooling2.PSProfileGasAlphaLaw, hRel,
+	)
+	if system, err := cooling2.GetInitedRotorConvTemperatureSystem(
+		coolMassRate, stage, rotorProfiler, segment, alphaAirFunc, alphaGasFunc, getODEConfig(), hRel,
+	); err != nil {
+		panic(err)
+	} else {
+		return system
+	}
+}
+
+func getRotorAlphaLaws(
+	coolMassRate,
+	meanAlphaGas float64,
+	stage turbine.StageNode,
+	rotorProfiler profilers.Profiler,
+	profile profiles.BladeProfile,
+	gasAlphaGenerator func(profiles.BladeProfile, float64, float64) cooling.AlphaLaw,
+	hRel float64,
+) (alphaGas cooling.AlphaLaw, alphaAir cooling.AlphaLaw) {
+	var pack = stage.GetDataPack()
+	var gasState, err = cooling2.NewRotorGasState(stage, rotorProfiler, hRel)
+	if err != nil {
+		panic(err)
+	}
+
+	var gas = stage.GasInput().GetState().(states2.GasPortState).Gas
+	var densityW = gasState.PStagW / (gas.R() * gasState.TStagW)
+	var massRateIntensity = densityW * gasState.W
+
+	var alphaInlet = cooling.CylinderAlphaLaw(gas, massRateIntensity, dInlet)(0, gasState.TStagW)
+
+	alphaGas = gasAlphaGenerator(
+		profile, alphaInlet, meanAlphaGas,
+	)
+	alphaAir = cooling.DefaultAirAlphaLaw(
+		gas,
+		geometry.Height(0, pack.StageGeometry.RotorGeometry()),
+		gapWidth, coolMassRate,
+	)
+	return
+}
@@ -0,0 +1,67 @@
+package diploma
+
+import (
+	"github.com/Sovianum/cooling-course-project/core"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s2n"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s2nr"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nb"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nc"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nr"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nrc"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3nsc"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/Sovianum/turbocycle/library/schemes"
+)
+
+// saveSchemeSummaryTemplate сводит параметры узлов всех рассмотренных схем;
+// основная трехвальная схема берется уже рассчитанной
+func saveSchemeSummaryTemplate(scheme schemes.ThreeShaftsScheme) {
+	var s2nScheme = s2n.GetInitedTwoShaftsScheme()
+	solveSingleSummaryScheme(s2nScheme)
+	var s2nrScheme = s2nr.GetInitedTwoShaftsRegeneratorScheme()
+	solveSingleSummaryScheme(s2nrScheme)
+	var s3nrScheme = s3nr.GetInitedThreeShaftsRegeneratorScheme()
+	solveDoubleSummaryScheme(s3nrScheme)
+	var s3ncScheme = s3nc.GetInitedThreeShaftsCoolingScheme()
+	solveDoubleSummaryScheme(s3ncScheme)
+	var s3nrcScheme = s3nrc.GetInitedThreeShaftsCoolRegeneratorScheme()
+	solveDoubleSummaryScheme(s3nrcScheme)
+	var s3nbScheme = s3nb.GetInitedThreeShaftsBurnScheme()
+	solveDoubleSummaryScheme(s3nbScheme)
+	var s3nscScheme = s3nsc.GetInitedThreeShaftsSubCompressScheme()
+	solveDoubleSummaryScheme(s3nscScheme)
+
+	var summaries = []dataframes.SchemeSummaryDF{
+		dataframes.NewSchemeSummaryDF(dataframes.NewTwoShaftsDF(power, etaR, s2nScheme)),
+		dataframes.NewSchemeSummaryDF(dataframes.NewTwoShaftsRegeneratorDF(power, etaR, s2nrScheme)),
+		dataframes.NewSchemeSummaryDF(dataframes.NewThreeShaftsDF(power, etaR, scheme)),
+		dataframes.NewSchemeSummaryDF(dataframes.NewThreeShaftsRegeneratorDF(power, etaR, s3nrScheme)),
+		dataframes.NewSchemeSummaryDF(dataframes.NewThreeShaftsCoolerDF(power, etaR, s3ncScheme)),
+		dataframes.NewSchemeSummaryDF(dataframes.NewThreeShaftsCoolingRegeneratorDF(power, etaR, s3nrcScheme)),
+		dataframes.NewSchemeSummaryDF(dataframes.NewThreeShaftsBurnDF(power, etaR, s3nbScheme)),
+		dataframes.NewSchemeSummaryDF(dataframes.NewThreeShaftsSubCompressDF(power, etaR, s3nscScheme)),
+	}
+
+	var inserter = templ.NewDataInserter(
+		templatePath(schemeSummaryTemplate),
+		buildDir+"/"+schemeSummaryOut,
+	)
+	if err := inserter.Insert(summaries); err != nil {
+		panic(err)
+	}
+}
+
+func solveSingleSummaryScheme(scheme core.SingleCompressorScheme) {
+	var generator = core.GetSingleCompressorDataGenerator(scheme, power/etaR, relaxCoef, iterNum)
+	if _, err := generator(summarySinglePi); err != nil {
+		panic(err)
+	}
+}
+
+func solveDoubleSummaryScheme(scheme core.DoubleCompressorScheme) {
+	var generator = core.GetDoubleCompressorDataGenerator(scheme, power/etaR, relaxCoef, iterNum)
+	if _, err := generator(summaryDoublePi, summaryPiFactor); err != nil {
+		panic(err)
+	}
+}
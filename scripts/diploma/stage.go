@@ -2,24 +2,27 @@ package diploma
 
 import (
 	"fmt"
-	"github.com/Sovianum/cooling-course-project/core/midall/inited"
+	"github.com/Sovianum/cooling-course-project/core/midall"
 	"github.com/Sovianum/cooling-course-project/core/profiling"
 	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/drawing"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
 	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
 	"github.com/Sovianum/turbocycle/common"
 	states2 "github.com/Sovianum/turbocycle/impl/engine/states"
+	"github.com/Sovianum/turbocycle/impl/stage/geometry"
 	"github.com/Sovianum/turbocycle/impl/stage/states"
 	"github.com/Sovianum/turbocycle/impl/stage/turbine"
 	"github.com/Sovianum/turbocycle/utils/turbine/geom"
 	"github.com/Sovianum/turbocycle/utils/turbine/radial/profilers"
 	"github.com/Sovianum/turbocycle/utils/turbine/radial/profiles"
 	"gonum.org/v1/gonum/mat"
-	"math"
+	"strings"
 )
 
 func saveProfilingTemplate() {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+profilingTemplate,
+		templatePath(profilingTemplate),
 		buildDir+"/"+profilingOut,
 	)
 	if err := inserter.Insert(nil); err != nil {
@@ -34,39 +37,15 @@ func saveProfiles(
 	dataNames [][]string,
 	isRotor bool,
 ) {
-	var profileArr = make([]profiles.BladeProfile, len(hRelArr))
-	for i, hRel := range hRelArr {
-		profileArr[i] = profiles.NewBladeProfileFromProfiler(
-			hRel,
-			0.05, 0.03,
-			0.35, 0.3,
-			profiler,
-		)
-	}
-
-	var installationAngleArr = make([]float64, len(hRelArr))
 	var tRelArr = make([]float64, len(hRelArr))
 	var tArr = common.LinSpace(0, 1, 200)
 
+	var coordinatesArr = make([][][][]float64, len(hRelArr))
 	for i, hRel := range hRelArr {
-		installationAngleArr[i] = profiler.InstallationAngle(hRel)
 		tRelArr[i] = turbine.TRel(hRel, geomGen)
-	}
-
-	var coordinatesArr = make([][][][]float64, len(hRelArr))
-	for i, bladeProfile := range profileArr {
 		coordinatesArr[i] = make([][][]float64, 2)
 
-		if isRotor {
-			bladeProfile.Transform(geom.Reflection(0))
-		}
-		bladeProfile.Transform(geom.Translation(mat.NewVecDense(2, []float64{-1, 0})))
-		if !isRotor {
-			bladeProfile.Transform(geom.Rotation(installationAngleArr[i] - math.Pi))
-		} else {
-			bladeProfile.Transform(geom.Rotation(-installationAngleArr[i]))
-		}
-
+		var bladeProfile = profiling.CascadeProfile(profiler, hRel, profileEdgeConfig, isRotor)
 		coordinatesArr[i][0] = geom.GetCoordinates(tArr, profiles.CircularSegment(bladeProfile))
 
 		bladeProfile.Transform(geom.Translation(mat.NewVecDense(2, []float64{
@@ -81,6 +60,9 @@ func saveProfiles(
 				panic(err)
 			}
 		}
+		var drawingName = strings.TrimSuffix(dataNames[i][0], "_1.csv")
+		saveDrawing(drawing.NewProfileDrawing(coordinatesArr[i][0]), drawingName+"_blade")
+		saveDrawing(drawing.NewCascadeDrawing(coordinatesArr[i]), drawingName+"_cascade")
 	}
 }
 
@@ -149,28 +131,28 @@ func getProfileMsg(profiler profilers.Profiler, hRel float64) string {
 }
 
 func getRotorProfiler(stage turbine.StageNode) profilers.Profiler {
-	var pack = stage.GetDataPack()
-	var profiler = profiling.GetInitedRotorProfiler(
-		stage.StageGeomGen().RotorGenerator(),
-		pack.RotorInletTriangle,
-		pack.RotorOutletTriangle,
-	)
-	return profiler
+	return getStageProfiler(stage, rotorProfilerSpec)
 }
 
 func getStatorProfiler(stage turbine.StageNode) profilers.Profiler {
-	var pack = stage.GetDataPack()
-	var profiler = profiling.GetInitedStatorProfiler(
-		stage.StageGeomGen().StatorGenerator(),
-		stage.VelocityInput().GetState().(states.VelocityPortState).Triangle,
-		pack.RotorInletTriangle,
-	)
+	return getStageProfiler(stage, statorProfilerSpec)
+}
+
+func getStageProfiler(stage turbine.StageNode, specName string) profilers.Profiler {
+	var spec, err = profiling.LoadProfilerSpec(configDir + "/" + specName)
+	if err != nil {
+		panic(err)
+	}
+	profiler, err := profiling.NewTurbineStageProfiler(spec, stage)
+	if err != nil {
+		panic(err)
+	}
 	return profiler
 }
 
 func saveTurbineStageTemplate(stage turbine.StageNode) {
 	var inserter = templ.NewDataInserter(
-		templatesDir+"/"+turbineStageTemplate,
+		templatePath(turbineStageTemplate),
 		buildDir+"/"+turbineStageOut,
 	)
 	var df, err = dataframes.NewTurbineStageDF(stage)
@@ -182,17 +164,12 @@ func saveTurbineStageTemplate(stage turbine.StageNode) {
 	}
 }
 
-func saveTurbineTotalTableTemplates() {
-	initedMachines, err := inited.GetInitedStagedNodes()
-	if err != nil {
-		panic(err)
-	}
-
-	hptDF := dataframes.NewStagedTurbineDF(initedMachines.HPT)
-	lptDF := dataframes.NewStagedTurbineDF(initedMachines.LPT)
-	ftDF := dataframes.NewStagedTurbineDF(initedMachines.FT)
+func saveTurbineTotalTableTemplates(machines *midall.StagedScheme3n) {
+	hptDF := dataframes.NewStagedTurbineDF(machines.HPT)
+	lptDF := dataframes.NewStagedTurbineDF(machines.LPT)
+	ftDF := dataframes.NewStagedTurbineDF(machines.FT)
 	inserter := templ.NewDataInserter(
-		templatesDir+"/"+turbineTotalTableTemplate,
+		templatePath(turbineTotalTableTemplate),
 		buildDir+"/"+turbineTotalTableOut,
 	)
 	if err := inserter.Insert(hptDF.Join(lptDF).Join(ftDF)); err != nil {
@@ -200,41 +177,53 @@ func saveTurbineTotalTableTemplates() {
 	}
 }
 
-func saveCompressorTotalTableTemplates() {
-	initedMachines, err := inited.GetInitedStagedNodes()
-	if err != nil {
-		panic(err)
-	}
-
+func saveCompressorTotalTableTemplates(machines *midall.StagedScheme3n) {
 	lpcInserter := templ.NewDataInserter(
-		templatesDir+"/"+lpcTotalTableTemplate,
+		templatePath(lpcTotalTableTemplate),
 		buildDir+"/"+lpcTotalTableOut,
 	)
-	lpcDF := dataframes.NewStagedCompressorDF(initedMachines.LPC)
+	lpcDF := dataframes.NewStagedCompressorDF(machines.LPC)
 	if err := lpcInserter.Insert(lpcDF); err != nil {
 		panic(err)
 	}
 
 	hpcInserter := templ.NewDataInserter(
-		templatesDir+"/"+hpcTotalTableTemplate,
+		templatePath(hpcTotalTableTemplate),
 		buildDir+"/"+hpcTotalTableOut,
 	)
-	hpcDF := dataframes.NewStagedCompressorDF(initedMachines.HPC)
+	hpcDF := dataframes.NewStagedCompressorDF(machines.HPC)
 	if err := hpcInserter.Insert(hpcDF); err != nil {
 		panic(err)
 	}
 }
 
-func saveCompressorStageTemplate() {
-	initedMachines, err := inited.GetInitedStagedNodes()
-	if err != nil {
+func saveCompressorFitTemplate(machines *midall.StagedScheme3n) {
+	var lpcDF = dataframes.NewCompressorFitDF("lpc", locale.T("КНД"), machines.LPCFit)
+	var hpcDF = dataframes.NewCompressorFitDF("hpc", locale.T("КВД"), machines.HPCFit)
+	for _, df := range []dataframes.CompressorFitDF{lpcDF, hpcDF} {
+		if df.HtLimitHit || df.EtaLimitHit {
+			fmt.Printf(
+				"%s fit hit limits: ht %v, eta %v (pi mismatch %.4f, eta mismatch %.4f)\n",
+				df.Name, df.HtLimitHit, df.EtaLimitHit, df.PiMismatch, df.EtaMismatch,
+			)
+		}
+	}
+
+	var inserter = templ.NewDataInserter(
+		templatePath(compressorFitTemplate),
+		buildDir+"/"+compressorFitOut,
+	)
+	if err := inserter.Insert([]dataframes.CompressorFitDF{lpcDF, hpcDF}); err != nil {
 		panic(err)
 	}
+}
+
+func saveCompressorStageTemplate(machines *midall.StagedScheme3n) {
 	inserter := templ.NewDataInserter(
-		templatesDir+"/"+compressorStageTemplate,
+		templatePath(compressorStageTemplate),
 		buildDir+"/"+compressorStageOut,
 	)
-	df := dataframes.NewCompressorStageDF(initedMachines.LPC.Stages()[0])
+	df := dataframes.NewCompressorStageDF(machines.LPC.Stages()[0])
 	if err := inserter.Insert(df); err != nil {
 		panic(err)
 	}
@@ -245,3 +234,36 @@ func solveParticularStage(stage turbine.StageNode) {
 		panic(err)
 	}
 }
+
+func saveBlade3D(
+	profiler profilers.Profiler,
+	bladingGeom geometry.BladingGeometry,
+	isRotor bool,
+	namePrefix string,
+) {
+	var grid, err = profiling.BuildBlade(profiler, bladingGeom, profiling.BladeConfig{
+		HRelArr:  common.LinSpace(0, 1, blade3DSectionNum),
+		PointNum: blade3DPointNum,
+		IsRotor:  isRotor,
+		Edges:    profileEdgeConfig,
+
+		Stacking: profiling.StackingConfig{
+			Mode:  blade3DStacking,
+			Lean:  common.ToRadians(blade3DLean),
+			Sweep: common.ToRadians(blade3DSweep),
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if err := profiling.SaveGridCSV(dataDir+"/"+namePrefix+".csv", grid); err != nil {
+		panic(err)
+	}
+	if err := profiling.SaveSTL(dataDir+"/"+namePrefix+".stl", grid); err != nil {
+		panic(err)
+	}
+	if err := profiling.SaveIGES(dataDir+"/"+namePrefix+".igs", grid); err != nil {
+		panic(err)
+	}
+}
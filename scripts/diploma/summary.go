@@ -0,0 +1,26 @@
+package diploma
+
+import (
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/summary"
+	"github.com/Sovianum/turbocycle/library/schemes"
+)
+
+func saveSummary(
+	scheme schemes.ThreeShaftsScheme,
+	machines *midall.StagedScheme3n,
+	gapCalcDF dataframes.GapCalcDF,
+	tempProfileDF dataframes.TProfileCalcDF,
+) {
+	var s = summary.New(
+		locale.T(summaryTitle),
+		dataframes.NewThreeShaftsDF(power, etaR, scheme),
+		machines, gapCalcDF, tempProfileDF,
+		getLifeConfig().Material.TWallMax,
+	)
+	if err := s.Save(buildDir + "/" + summaryOut); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/schemes/s3n"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"path/filepath"
+	"strings"
+)
+
+// passiveTemplates копируются в каталог сборки без подстановок
+var passiveTemplates = []string{
+	"ecology.tex", "economics.tex", "ending.tex",
+	"referat.tex", "technology.tex", "intro.tex",
+	"literature.tex", "cycle_comparison.tex",
+	"cooling_optimization.tex", "application.tex",
+}
+
+// templateRegistry связывает каждый шаблон с типом данных, которые передаются в DataInserter.Insert
+var templateRegistry = templ.NewRegistry(
+	templatesDir,
+	append(passiveEntries(),
+		templ.Register(projectInputTemplate, s3n.InitDF{}),
+		templ.Register(cycleInputTemplate, s3n.InitDF{}),
+		templ.Register(variantTemplate, dataframes.VariantDF{}),
+		templ.Register(cycleTemplate, dataframes.ThreeShaftsDF{}),
+		templ.Register(rootTemplate, nil),
+		templ.Register(titleTemplate, nil),
+		templ.Register(profilingTemplate, nil),
+		templ.Register(turbineStageTemplate, dataframes.TurbineStageDF{}),
+		templ.Register(compressorStageTemplate, dataframes.CompressorStageDF{}),
+		templ.Register(lpcTotalTableTemplate, dataframes.StagedCompressorDF{}),
+		templ.Register(hpcTotalTableTemplate, dataframes.StagedCompressorDF{}),
+		templ.Register(compressorFitTemplate, []dataframes.CompressorFitDF{}),
+		templ.Register(compressorLossTemplate, []dataframes.CompressorLossDF{}),
+		templ.Register(turbineTotalTableTemplate, dataframes.StagedTurbineDF{}),
+		templ.Register(ftTotalTableTemplate, dataframes.StagedTurbineDF{}),
+		templ.Register(turbineLossTemplate, []dataframes.TurbineLossDF{}),
+		templ.Register(equilibriumTemplate, dataframes.RadialEquilibriumDF{}),
+		templ.Register(profileQualityTemplate, []dataframes.ProfileQualityDF{}),
+		templ.Register(cooling1Template, dataframes.GapCalcDF{}),
+		templ.Register(cooling2Template, dataframes.TProfileCalcDF{}),
+		templ.Register(rotorCoolingTemplate, dataframes.RotorCoolingDF{}),
+		templ.Register(lifeTemplate, dataframes.LifeDF{}),
+		templ.Register(schemeSummaryTemplate, []dataframes.SchemeSummaryDF{}),
+		templ.Register(graphTableTemplate, dataframes.GraphDF{}),
+	)...,
+)
+
+func passiveEntries() []templ.Entry {
+	var result = make([]templ.Entry, len(passiveTemplates))
+	for i, name := range passiveTemplates {
+		result[i] = templ.Register(name, nil)
+	}
+	return result
+}
+
+// CheckTemplates разбирает все шаблоны, включая переводы, и проверяет обращения к полям данных без запуска расчетов.
+// Шаблон, которого нет в переводе на один из языков, считается ошибкой.
+func CheckTemplates() []error {
+	var result = templateRegistry.Check()
+	for _, lang := range locale.Langs[1:] {
+		result = append(result, templateRegistry.Overlay(langTemplatesDir(lang)).Check()...)
+	}
+	return result
+}
+
+// templatePath возвращает путь к шаблону name на текущем языке.
+// Перевод всех шаблонов проверяется в checkTemplates до начала расчетов.
+func templatePath(name string) string {
+	var lang = locale.Current()
+	if lang == locale.Russian {
+		return filepath.Join(templatesDir, name)
+	}
+	return filepath.Join(langTemplatesDir(lang), name)
+}
+
+// langTemplatesDir - каталог переводов шаблонов; русские шаблоны лежат непосредственно в templatesDir
+func langTemplatesDir(lang locale.Lang) string {
+	return filepath.Join(templatesDir, string(lang))
+}
+
+func checkTemplates() {
+	var errs = CheckTemplates()
+	if len(errs) == 0 {
+		return
+	}
+	var messages = make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	panic(fmt.Errorf("template check failed:\n%s", strings.Join(messages, "\n")))
+}
@@ -0,0 +1,70 @@
+package diploma
+
+import (
+	"fmt"
+	"github.com/Sovianum/cooling-course-project/core/midall"
+	"github.com/Sovianum/cooling-course-project/core/profiling"
+	"github.com/Sovianum/cooling-course-project/postprocessing/dataframes"
+	"github.com/Sovianum/cooling-course-project/postprocessing/locale"
+	"github.com/Sovianum/cooling-course-project/postprocessing/templ"
+	"github.com/Sovianum/turbocycle/impl/stage/turbine"
+)
+
+// saveTurbineLossComparison сравнивает коэффициенты скорости турбин, подобранных по циклу, с моделью потерь
+func saveTurbineLossComparison(fitted *midall.StagedScheme3n) {
+	var dfs []dataframes.TurbineLossDF
+	for _, item := range []struct {
+		name  string
+		title string
+		node  turbine.StagedTurbineNode
+	}{
+		{"hpt", locale.T("ТВД"), fitted.HPT},
+		{"lpt", locale.T("ТНД"), fitted.LPT},
+		{"ft", locale.T("СТ"), fitted.FT},
+	} {
+		var predictions, err = midall.PredictTurbineLosses(item.node, getLossModelConfig())
+		if err != nil {
+			panic(err)
+		}
+
+		var matrix = make([][]float64, len(predictions))
+		for i, p := range predictions {
+			fmt.Printf(
+				"%s stage %d: phi %.3f / %.3f, psi %.3f / %.3f, eta_u %.3f / %.3f, eta* %.3f / %.3f\n",
+				item.name, i+1,
+				p.PhiFitted, p.PhiPredicted, p.PsiFitted, p.PsiPredicted,
+				p.EtaUFitted, p.EtaUPredicted, p.EtaFitted, p.EtaPredicted,
+			)
+			matrix[i] = []float64{
+				float64(i + 1),
+				p.PhiFitted, p.PhiPredicted,
+				p.PsiFitted, p.PsiPredicted,
+				p.EtaUFitted, p.EtaUPredicted,
+				p.EtaFitted, p.EtaPredicted,
+				p.StatorLoss.Profile, p.StatorLoss.Secondary, p.StatorLoss.TrailingEdge,
+				p.RotorLoss.Profile, p.RotorLoss.Secondary, p.RotorLoss.TipClearance, p.RotorLoss.TrailingEdge,
+			}
+		}
+		if err := profiling.SaveMatrix(dataDir+"/"+item.name+"_"+turbineLossData, matrix); err != nil {
+			panic(err)
+		}
+		dfs = append(dfs, dataframes.NewTurbineLossDF(item.name, item.title, useLossModel, predictions))
+	}
+
+	var inserter = templ.NewDataInserter(
+		templatePath(turbineLossTemplate),
+		buildDir+"/"+turbineLossOut,
+	)
+	if err := inserter.Insert(dfs); err != nil {
+		panic(err)
+	}
+}
+
+func getLossModelConfig() midall.LossModelConfig {
+	return midall.LossModelConfig{
+		StatorThicknessRel:   lossStatorThicknessRel,
+		RotorThicknessRel:    lossRotorThicknessRel,
+		StatorTEThicknessRel: lossStatorTEThicknessRel,
+		RotorTEThicknessRel:  lossRotorTEThicknessRel,
+	}
+}